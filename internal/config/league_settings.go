@@ -4,21 +4,49 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
 // LeagueSettings represents the configuration for a specific league
 type LeagueSettings struct {
-	Name        string        `json:"name"`
-	Description string        `json:"description"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
 	Custom      CustomStandings `json:"custom_standings"`
+	Playoffs    PlayoffSeeding  `json:"playoff_seeding"`
+	Owners      OwnerIdentity   `json:"owner_identity"`
 }
 
 // CustomStandings represents custom standings configuration
 type CustomStandings struct {
-	Enabled       bool     `json:"enabled"`
-	Instructions  string   `json:"instructions"`
-	TiebreakOrder []string `json:"tiebreak_order"`
-	Notes         string   `json:"notes"`
+	Enabled         bool     `json:"enabled"`
+	Instructions    string   `json:"instructions"`
+	TiebreakOrder   []string `json:"tiebreak_order"`
+	Notes           string   `json:"notes"`
+	RandomSeed      string   `json:"random_seed,omitempty"`      // Published seed for the "random" tiebreaker; defaults to "<league_id>:<season>"
+	StandingsSource string   `json:"standings_source,omitempty"` // "rosters" (default) or "matchups"
+}
+
+// PlayoffSeeding represents how a league assigns playoff seeds
+type PlayoffSeeding struct {
+	PlayoffTeams       int    `json:"playoff_teams,omitempty"`        // Overrides the league's playoff_teams setting
+	DivisionWinners    string `json:"division_winners,omitempty"`     // "", "guaranteed" (in, seeded by record) or "top_seeds" (in, seeded first)
+	PointsForWildcards int    `json:"points_for_wildcards,omitempty"` // Number of final spots awarded by points for instead of record
+	Byes               *int   `json:"byes,omitempty"`                 // First-round byes; defaults to what the bracket size implies
+	Reseed             bool   `json:"reseed"`                         // Re-pair best vs worst remaining seed after each round
+}
+
+// OwnerIdentity represents manual corrections to how franchises map to owners across seasons
+type OwnerIdentity struct {
+	Aliases   map[string]string   `json:"aliases,omitempty"`   // Extra user ID to the owner's primary user ID, for owners with more than one account
+	Takeovers []FranchiseTakeover `json:"takeovers,omitempty"` // Franchises that changed hands
+}
+
+// FranchiseTakeover records a user taking over a franchise, e.g. "user X took over roster 4 in 2022".
+// The franchise belongs to the user from that season on, until a later takeover of the same roster.
+type FranchiseTakeover struct {
+	UserID   string `json:"user_id"`
+	RosterID int    `json:"roster_id"`
+	Season   string `json:"season"`
 }
 
 // LeagueConfig represents the entire league configuration file
@@ -27,6 +55,12 @@ type LeagueConfig struct {
 	Leagues         map[string]LeagueSettings `json:"leagues"`
 	DefaultSettings LeagueSettings            `json:"default_settings"`
 	Template        map[string]LeagueSettings `json:"_template,omitempty"`
+	// NFL schedule files, one per season, that add to or replace the bundled schedules.
+	// Relative paths are resolved from the config file's directory.
+	ScheduleFiles []string `json:"schedule_files,omitempty"`
+	// Directory holding the weekly stats files rescore_season can read. Relative paths
+	// are resolved from the config file's directory; defaults to "stats" beside it.
+	StatsDir string `json:"stats_dir,omitempty"`
 }
 
 // LoadLeagueSettings loads league configuration from the settings file
@@ -74,6 +108,19 @@ func LoadLeagueSettings() (*LeagueConfig, error) {
 		return nil, fmt.Errorf("failed to parse league settings from %s: %w", foundPath, err)
 	}
 	
+	for i, path := range config.ScheduleFiles {
+		if !filepath.IsAbs(path) {
+			config.ScheduleFiles[i] = filepath.Join(filepath.Dir(foundPath), path)
+		}
+	}
+	
+	if config.StatsDir == "" {
+		config.StatsDir = "stats"
+	}
+	if !filepath.IsAbs(config.StatsDir) {
+		config.StatsDir = filepath.Join(filepath.Dir(foundPath), config.StatsDir)
+	}
+	
 	return &config, nil
 }
 
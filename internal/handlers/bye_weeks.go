@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/nfl"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus"
+)
+
+// ByeConflict represents a week where byes leave a roster short at a position
+type ByeConflict struct {
+	Week          int      `json:"week"`
+	Position      string   `json:"position"`
+	StartersOnBye int      `json:"starters_on_bye"`
+	Players       []string `json:"players"`   // Starters on bye
+	Available     int      `json:"available"` // Rostered players at the position who are playing
+	Description   string   `json:"description"`
+}
+
+// ScheduledPlayer represents a rostered player's NFL game or bye in one week
+type ScheduledPlayer struct {
+	PlayerID string `json:"player_id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	Team     string `json:"team"`
+	Starter  bool   `json:"starter"`
+	Opponent string `json:"opponent,omitempty"`
+	Home     bool   `json:"home,omitempty"`
+}
+
+// RosterWeekSchedule represents a roster's NFL schedule in one week
+type RosterWeekSchedule struct {
+	Week          int               `json:"week"`
+	StartersOnBye int               `json:"starters_on_bye"`
+	OnBye         []ScheduledPlayer `json:"on_bye,omitempty"`
+	Playing       []ScheduledPlayer `json:"playing,omitempty"` // Only when the schedule lists games
+}
+
+// RemainingSchedule represents a roster's NFL schedule for the rest of the fantasy regular season
+type RemainingSchedule struct {
+	LeagueID     string               `json:"league_id"`
+	RosterID     int                  `json:"roster_id"`
+	Season       string               `json:"season"`
+	FromWeek     int                  `json:"from_week"`
+	ToWeek       int                  `json:"to_week"`
+	HasGames     bool                 `json:"has_games"` // False when only bye weeks are known
+	Weeks        []RosterWeekSchedule `json:"weeks"`
+	ByeConflicts []ByeConflict        `json:"bye_conflicts,omitempty"`
+}
+
+// scheduleLoader loads the NFL schedules the first time a tool needs them, so
+// creating a handler doesn't read the league settings from disk
+type scheduleLoader struct {
+	once   sync.Once
+	store  *nfl.Store
+	logger *logrus.Logger
+}
+
+// newScheduleLoader creates a loader that reads the schedules on first use
+func newScheduleLoader(logger *logrus.Logger) *scheduleLoader {
+	return &scheduleLoader{logger: logger}
+}
+
+// season returns the schedule for a season, or nil when none is loaded
+func (l *scheduleLoader) season(season string) *nfl.SeasonSchedule {
+	l.once.Do(func() {
+		l.store = loadNFLSchedule(l.logger)
+	})
+	return l.store.Season(season)
+}
+
+// noScheduleNote explains why bye weeks weren't checked for a season
+func noScheduleNote(season string) string {
+	return fmt.Sprintf("No NFL schedule is loaded for the %s season, so bye weeks weren't checked; add one with schedule_files in configs/league_settings.json", season)
+}
+
+// loadNFLSchedule loads the bundled NFL schedules plus any schedule files named in the
+// league settings. Problems are logged and leave the schedule incomplete rather than failing.
+func loadNFLSchedule(logger *logrus.Logger) *nfl.Store {
+	store, err := nfl.NewStore()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to load bundled NFL schedules, bye weeks unavailable")
+		return nil
+	}
+
+	leagueConfig, err := config.LoadLeagueSettings()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to load league settings, using bundled NFL schedules only")
+		return store
+	}
+	for _, path := range leagueConfig.ScheduleFiles {
+		if err := store.LoadFile(path); err != nil {
+			logger.WithError(err).WithField("path", path).Warn("Failed to load NFL schedule file")
+		}
+	}
+
+	return store
+}
+
+// remainingWeeks returns the fantasy regular season weeks that haven't been scored yet
+func remainingWeeks(league *sleeper.League) (int, int) {
+	startWeek, endWeek := regularSeasonWeeks(league)
+	if league.Settings.LastScoredLeg >= startWeek {
+		startWeek = league.Settings.LastScoredLeg + 1
+	}
+	return startWeek, endWeek
+}
+
+// findByeConflicts finds weeks where two or more starters at a position are on bye, or where
+// byes leave too few players to fill the position's starting slots. Positions that are short
+// without any byes aren't bye conflicts, so they are left out.
+func findByeConflicts(roster *sleeper.Roster, allPlayers map[string]sleeper.Player, rosterPositions []string, schedule *nfl.SeasonSchedule, fromWeek, toWeek int) []ByeConflict {
+	if schedule == nil {
+		return nil
+	}
+
+	starters := make(map[string]bool)
+	for _, playerID := range roster.Starters {
+		starters[playerID] = true
+	}
+
+	var conflicts []ByeConflict
+	for week := fromWeek; week <= toWeek; week++ {
+		for _, requirement := range rosterRequirements(rosterPositions) {
+			conflict := ByeConflict{Week: week, Position: requirement.Position}
+			onBye := 0
+			for _, playerID := range roster.Players {
+				player, exists := allPlayers[playerID]
+				if !exists || !containsString(playerPositions(player), requirement.Position) {
+					continue
+				}
+				if schedule.ByeWeek(player.Team) != week {
+					conflict.Available++
+					continue
+				}
+				onBye++
+				if starters[playerID] {
+					conflict.StartersOnBye++
+					conflict.Players = append(conflict.Players, playerName(player))
+				}
+			}
+
+			switch {
+			case onBye > 0 && conflict.Available < requirement.StartingSlots:
+				if conflict.Available == 0 {
+					conflict.Description = fmt.Sprintf("No %s available in week %d", requirement.Position, week)
+				} else {
+					conflict.Description = fmt.Sprintf("Only %d %s available in week %d for %d starting slots",
+						conflict.Available, requirement.Position, week, requirement.StartingSlots)
+				}
+			case conflict.StartersOnBye >= 2:
+				conflict.Description = fmt.Sprintf("%d of your starting %ss are on bye week %d",
+					conflict.StartersOnBye, requirement.Position, week)
+			default:
+				continue
+			}
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
+
+// GetRemainingScheduleTool returns the MCP tool definition for get_remaining_schedule
+func (h *RosterHandler) GetRemainingScheduleTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_remaining_schedule",
+		Description: "Show a roster's NFL schedule for the rest of the fantasy regular season: who is on bye each week, bye week conflicts that leave a position short, and each player's opponent when the full NFL schedule is loaded",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"roster_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "The roster ID of the team",
+					"required":    true,
+				},
+				"from_week": map[string]interface{}{
+					"type":        "integer",
+					"description": "First week to show (default: the first unscored week)",
+					"required":    false,
+				},
+				"to_week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Last week to show (default: end of the fantasy regular season)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetRemainingSchedule handles the get_remaining_schedule tool call
+func (h *RosterHandler) HandleGetRemainingSchedule(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_remaining_schedule")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+	rosterIDFloat, ok := args["roster_id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("roster_id is required and must be an integer")
+	}
+	rosterID := int(rosterIDFloat)
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	schedule := h.schedule.season(league.Season)
+	if schedule == nil {
+		return toolErrorResult(fmt.Sprintf("No NFL schedule is loaded for the %s season; add one with schedule_files in configs/league_settings.json", league.Season)), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+	var roster *sleeper.Roster
+	for i := range rosters {
+		if rosters[i].RosterID == rosterID {
+			roster = &rosters[i]
+			break
+		}
+	}
+	if roster == nil {
+		return toolErrorResult(fmt.Sprintf("Roster %d not found in league", rosterID)), nil
+	}
+
+	allPlayers, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+
+	fromWeek, toWeek := remainingWeeks(league)
+	if weekFloat, ok := args["from_week"].(float64); ok && weekFloat > 0 {
+		fromWeek = int(weekFloat)
+	}
+	if weekFloat, ok := args["to_week"].(float64); ok && weekFloat > 0 {
+		toWeek = int(weekFloat)
+	}
+
+	remaining := buildRemainingSchedule(roster, allPlayers, schedule, fromWeek, toWeek)
+	remaining.LeagueID = leagueID
+	remaining.Season = league.Season
+	remaining.ByeConflicts = findByeConflicts(roster, allPlayers, league.RosterPositions, schedule, fromWeek, toWeek)
+
+	summary := fmt.Sprintf("Roster %d's schedule for weeks %d-%d", rosterID, fromWeek, toWeek)
+	if len(remaining.ByeConflicts) > 0 {
+		summary += fmt.Sprintf(": %d bye week conflicts, starting with %s", len(remaining.ByeConflicts), remaining.ByeConflicts[0].Description)
+	} else {
+		summary += ": no bye week conflicts"
+	}
+	if !remaining.HasGames {
+		summary += fmt.Sprintf(". Only bye weeks are loaded for %s; add the full schedule with schedule_files to see opponents", league.Season)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    remaining,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: 3,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildRemainingSchedule lists each week's byes and games for a roster's players,
+// starters first
+func buildRemainingSchedule(roster *sleeper.Roster, allPlayers map[string]sleeper.Player, schedule *nfl.SeasonSchedule, fromWeek, toWeek int) *RemainingSchedule {
+	remaining := &RemainingSchedule{
+		RosterID: roster.RosterID,
+		FromWeek: fromWeek,
+		ToWeek:   toWeek,
+		HasGames: schedule.HasGames(),
+		Weeks:    []RosterWeekSchedule{},
+	}
+
+	starters := make(map[string]bool)
+	for _, playerID := range roster.Starters {
+		starters[playerID] = true
+	}
+	playerIDs := make([]string, len(roster.Players))
+	copy(playerIDs, roster.Players)
+	sort.SliceStable(playerIDs, func(i, j int) bool {
+		return starters[playerIDs[i]] && !starters[playerIDs[j]]
+	})
+
+	for week := fromWeek; week <= toWeek; week++ {
+		weekSchedule := RosterWeekSchedule{Week: week}
+		for _, playerID := range playerIDs {
+			player, exists := allPlayers[playerID]
+			if !exists {
+				continue
+			}
+			game, known := schedule.Game(player.Team, week)
+			if !known {
+				continue
+			}
+
+			scheduled := ScheduledPlayer{
+				PlayerID: playerID,
+				Name:     playerName(player),
+				Position: player.Position,
+				Team:     player.Team,
+				Starter:  starters[playerID],
+			}
+			if game.Bye {
+				weekSchedule.OnBye = append(weekSchedule.OnBye, scheduled)
+				if scheduled.Starter {
+					weekSchedule.StartersOnBye++
+				}
+				continue
+			}
+			scheduled.Opponent = game.Opponent
+			scheduled.Home = game.Home
+			weekSchedule.Playing = append(weekSchedule.Playing, scheduled)
+		}
+		remaining.Weeks = append(remaining.Weeks, weekSchedule)
+	}
+
+	return remaining
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/nfl"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// newByeWeekMockClient returns a league whose lone QB plays for DET (bye week 5 in 2024)
+// and whose starting WRs play for PIT and SF (both bye week 9 in 2024)
+func newByeWeekMockClient(season string) *MockSleeperClient {
+	return &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID:        leagueID,
+				Season:          season,
+				RosterPositions: []string{"QB", "WR", "WR", "BN", "BN"},
+				Settings:        sleeper.LeagueSettings{StartWeek: 1, PlayoffWeekStart: 15, LastScoredLeg: 4},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, Players: []string{"qb1", "wr1", "wr2", "wr3"}, Starters: []string{"qb1", "wr1", "wr2"}},
+			}, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"qb1": {PlayerID: "qb1", FirstName: "Jared", LastName: "Goff", Position: "QB", Team: "DET"},
+				"wr1": {PlayerID: "wr1", FirstName: "George", LastName: "Pickens", Position: "WR", Team: "PIT"},
+				"wr2": {PlayerID: "wr2", FirstName: "Deebo", LastName: "Samuel", Position: "WR", Team: "SF"},
+				"wr3": {PlayerID: "wr3", FirstName: "Tyler", LastName: "Lockett", Position: "WR", Team: "SEA"},
+			}, nil
+		},
+	}
+}
+
+// bundledScheduleLoader returns a loader holding only the bundled schedules, so tests
+// don't depend on the schedule files named in configs/league_settings.json
+func bundledScheduleLoader(t *testing.T) *scheduleLoader {
+	store, err := nfl.NewStore()
+	if err != nil {
+		t.Fatalf("Failed to load bundled schedules: %v", err)
+	}
+	loader := &scheduleLoader{store: store}
+	loader.once.Do(func() {})
+	return loader
+}
+
+func TestFindByeConflicts(t *testing.T) {
+	schedule := bundledScheduleLoader(t).season("2024")
+	rosterPositions := []string{"QB", "WR", "WR", "BN", "BN"}
+	roster := &sleeper.Roster{RosterID: 1, Players: []string{"qb1", "wr1", "wr2", "wr3"}, Starters: []string{"qb1", "wr1", "wr2"}}
+	// Goff's DET bye is week 5; Pickens (PIT) and Samuel (SF) share week 9
+	players := map[string]sleeper.Player{
+		"qb1": {PlayerID: "qb1", FirstName: "Jared", LastName: "Goff", Position: "QB", Team: "DET"},
+		"wr1": {PlayerID: "wr1", FirstName: "George", LastName: "Pickens", Position: "WR", Team: "PIT"},
+		"wr2": {PlayerID: "wr2", FirstName: "Deebo", LastName: "Samuel", Position: "WR", Team: "SF"},
+		"wr3": {PlayerID: "wr3", FirstName: "Tyler", LastName: "Lockett", Position: "WR", Team: "SEA"},
+	}
+
+	conflicts := findByeConflicts(roster, players, rosterPositions, schedule, 5, 14)
+
+	if len(conflicts) != 2 {
+		t.Fatalf("Expected 2 conflicts, got %+v", conflicts)
+	}
+	if conflicts[0].Week != 5 || conflicts[0].Position != "QB" || conflicts[0].Description != "No QB available in week 5" {
+		t.Errorf("Expected no QB in week 5, got %+v", conflicts[0])
+	}
+	if conflicts[1].Week != 9 || conflicts[1].Description != "Only 1 WR available in week 9 for 2 starting slots" {
+		t.Errorf("Expected a WR shortage in week 9, got %+v", conflicts[1])
+	}
+	if conflicts[1].StartersOnBye != 2 || len(conflicts[1].Players) != 2 {
+		t.Errorf("Expected both starting WRs on bye in week 9, got %+v", conflicts[1])
+	}
+
+	// A deeper bench covers the week 9 shortage, but two starters are still out
+	players["wr4"] = sleeper.Player{PlayerID: "wr4", Position: "WR", Team: "KC"}
+	roster.Players = append(roster.Players, "wr4")
+	conflicts = findByeConflicts(roster, players, rosterPositions, schedule, 9, 9)
+	if len(conflicts) != 1 || conflicts[0].Description != "2 of your starting WRs are on bye week 9" {
+		t.Errorf("Expected 2 starting WRs on bye in week 9, got %+v", conflicts)
+	}
+
+	// A position the roster can't fill in any week isn't a bye conflict
+	if conflicts := findByeConflicts(roster, players, []string{"QB", "WR", "WR", "K", "BN"}, schedule, 6, 8); len(conflicts) != 0 {
+		t.Errorf("Expected no conflicts for an empty kicker slot, got %+v", conflicts)
+	}
+
+	if conflicts := findByeConflicts(roster, players, rosterPositions, nil, 1, 14); conflicts != nil {
+		t.Errorf("Expected no conflicts without a schedule, got %+v", conflicts)
+	}
+}
+
+func TestRosterHandler_AnalyzeRosterStrength_ByeConflicts(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewRosterHandler(newByeWeekMockClient("2024"), logger)
+	handler.schedule = bundledScheduleLoader(t)
+
+	result, err := handler.HandleAnalyzeRosterStrength(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(1),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+	if !strings.Contains(textContent.Text, "No QB available in week 5") {
+		t.Errorf("Expected the week 5 QB bye in the insights, got:\n%s", textContent.Text)
+	}
+
+	// Seasons without a loaded schedule say so instead of silently skipping byes
+	handler = NewRosterHandler(newByeWeekMockClient("2030"), logger)
+	handler.schedule = bundledScheduleLoader(t)
+	result, err = handler.HandleAnalyzeRosterStrength(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(1),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, noScheduleNote("2030")) || strings.Contains(text, "week 5") {
+		t.Errorf("Expected a note that 2030 byes weren't checked, got:\n%s", text)
+	}
+}
+
+func TestRosterHandler_HandleGetRemainingSchedule(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewRosterHandler(newByeWeekMockClient("2024"), logger)
+	handler.schedule = bundledScheduleLoader(t)
+
+	result, err := handler.HandleGetRemainingSchedule(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(1),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool              `json:"success"`
+		Data    RemainingSchedule `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	remaining := response.Data
+	if remaining.FromWeek != 5 || remaining.ToWeek != 14 || len(remaining.Weeks) != 10 {
+		t.Fatalf("Expected weeks 5-14, got %d-%d with %d weeks", remaining.FromWeek, remaining.ToWeek, len(remaining.Weeks))
+	}
+	if remaining.HasGames {
+		t.Error("Expected the bundled 2024 schedule to list byes only")
+	}
+
+	week9 := remaining.Weeks[4]
+	if week9.Week != 9 || week9.StartersOnBye != 2 || len(week9.OnBye) != 2 {
+		t.Errorf("Expected both starting WRs on bye in week 9, got %+v", week9)
+	}
+	if len(remaining.ByeConflicts) != 2 {
+		t.Errorf("Expected 2 bye conflicts, got %+v", remaining.ByeConflicts)
+	}
+
+	// Seasons without a loaded schedule report an error
+	handler = NewRosterHandler(newByeWeekMockClient("1999"), logger)
+	handler.schedule = bundledScheduleLoader(t)
+	result, err = handler.HandleGetRemainingSchedule(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(1),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for a season without a schedule")
+	}
+
+	if _, err := handler.HandleGetRemainingSchedule(context.Background(), map[string]interface{}{"league_id": "league1"}); err == nil {
+		t.Error("Expected an error without a roster_id")
+	}
+}
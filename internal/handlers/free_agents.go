@@ -0,0 +1,395 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// freeAgentTrendingHours covers a full waiver cycle of add activity
+const freeAgentTrendingHours = 168
+
+// FreeAgent represents an unrostered player ranked as a pickup
+type FreeAgent struct {
+	PlayerID      string   `json:"player_id"`
+	Name          string   `json:"name"`
+	Position      string   `json:"position"`
+	Team          string   `json:"team"`
+	InjuryStatus  string   `json:"injury_status,omitempty"`
+	Score         float64  `json:"score"`          // 0-100 blend of recent points, trending adds and projection
+	RecentPoints  float64  `json:"recent_points"`  // Under this league's scoring
+	RecentAverage float64  `json:"recent_average"` // Per week with stats
+	WeeksPlayed   int      `json:"weeks_played"`
+	TrendingAdds  int      `json:"trending_adds"`
+	Projection    *float64 `json:"projection,omitempty"` // Next week, when projections were requested
+	Addresses     []string `json:"addresses,omitempty"`  // Weak positions on the requesting roster this player helps
+}
+
+// FreeAgentReport represents the ranked free agent pool for a league
+type FreeAgentReport struct {
+	LeagueID       string      `json:"league_id"`
+	Season         string      `json:"season"`
+	RecentWeeks    []int       `json:"recent_weeks"`
+	ProjectionWeek int         `json:"projection_week,omitempty"`
+	Positions      []string    `json:"positions"` // Positions the league's lineup uses
+	RosterID       int         `json:"roster_id,omitempty"`
+	Weaknesses     []string    `json:"weaknesses,omitempty"`
+	TotalAvailable int         `json:"total_available"`
+	FreeAgents     []FreeAgent `json:"free_agents"`
+	Warnings       []string    `json:"warnings,omitempty"`
+}
+
+// GetFreeAgentsTool returns the MCP tool definition for get_free_agents
+func (h *PlayerHandler) GetFreeAgentsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_free_agents",
+		Description: "Find the best available players in a league: everyone not on a roster (including reserve and taxi) at positions the league starts, ranked by recent points under the league's scoring, trending adds and optionally next week's projection. Pass a roster_id to see which of that team's weak positions each pickup addresses.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"roster_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "The roster looking for pickups, to match players to its weak positions",
+					"required":    false,
+				},
+				"position": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players eligible at this position (e.g. RB)",
+					"required":    false,
+				},
+				"weeks": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of recent weeks of scoring to consider (default: 3)",
+					"required":    false,
+				},
+				"include_projections": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also rank by next week's projected points (default: false)",
+					"required":    false,
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum players to return (default: 25, max: 100)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetFreeAgents handles the get_free_agents tool call
+func (h *PlayerHandler) HandleGetFreeAgents(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_free_agents")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	rosterID := 0
+	if rosterFloat, ok := args["roster_id"].(float64); ok {
+		rosterID = int(rosterFloat)
+	}
+	position, _ := args["position"].(string)
+	weeks := 3
+	if weeksFloat, ok := args["weeks"].(float64); ok && weeksFloat > 0 {
+		weeks = int(weeksFloat)
+	}
+	includeProjections, _ := args["include_projections"].(bool)
+	limit := 25
+	if limitFloat, ok := args["limit"].(float64); ok && limitFloat > 0 {
+		limit = int(math.Min(limitFloat, 100))
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+
+	players, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+	apiCalls := 3
+
+	var requesting *sleeper.Roster
+	if rosterID != 0 {
+		for i := range rosters {
+			if rosters[i].RosterID == rosterID {
+				requesting = &rosters[i]
+				break
+			}
+		}
+		if requesting == nil {
+			return toolErrorResult(fmt.Sprintf("Roster %d not found in league", rosterID)), nil
+		}
+	}
+
+	report := &FreeAgentReport{
+		LeagueID:   leagueID,
+		Season:     league.Season,
+		Positions:  leaguePositions(league.RosterPositions),
+		RosterID:   rosterID,
+		FreeAgents: []FreeAgent{},
+	}
+	if requesting != nil {
+		report.Weaknesses = rosterWeaknesses(requesting, players, league.RosterPositions)
+	}
+
+	// Recent weeks end at the league's last scored week
+	lastScored := league.Settings.LastScoredLeg
+	for week := lastScored - weeks + 1; week <= lastScored; week++ {
+		if week >= 1 {
+			report.RecentWeeks = append(report.RecentWeeks, week)
+		}
+	}
+
+	recentPoints := make(map[string][]float64)
+	for _, week := range report.RecentWeeks {
+		weekPoints := make(map[string]float64)
+
+		// Points a player scored while rostered come straight from the league's matchups
+		matchups, err := h.client.GetMatchups(leagueID, week)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithField("week", week).Warn("Failed to get matchups for week")
+			report.Warnings = append(report.Warnings, fmt.Sprintf("Week %d: failed to get matchups (%s)", week, err.Error()))
+		}
+		for _, matchup := range matchups {
+			for playerID, points := range matchup.PlayersPoints {
+				weekPoints[playerID] = points
+			}
+		}
+
+		// Everyone else is scored from their stat line under the league's scoring
+		stats, err := h.client.GetWeeklyStats("regular", league.Season, week)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithField("week", week).Warn("Failed to get weekly stats")
+			report.Warnings = append(report.Warnings, fmt.Sprintf("Week %d: failed to get stats (%s)", week, err.Error()))
+		}
+		for playerID, playerStats := range stats {
+			if _, scored := weekPoints[playerID]; !scored {
+				weekPoints[playerID] = scorePlayerStats(playerStats, league.ScoringSettings)
+			}
+		}
+
+		for playerID, points := range weekPoints {
+			recentPoints[playerID] = append(recentPoints[playerID], points)
+		}
+	}
+
+	trendingAdds := make(map[string]int)
+	trending, err := h.client.GetTrendingPlayers("nfl", "add", freeAgentTrendingHours, 200)
+	apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get trending players, ranking without trends")
+		report.Warnings = append(report.Warnings, fmt.Sprintf("Failed to get trending players (%s)", err.Error()))
+	}
+	for _, trend := range trending {
+		trendingAdds[trend.PlayerID] = trend.Count
+	}
+
+	var projections map[string]sleeper.PlayerStats
+	if includeProjections {
+		report.ProjectionWeek = lastScored + 1
+		projections, err = h.client.GetProjections("regular", league.Season, report.ProjectionWeek)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).Warn("Failed to get projections, ranking without projections")
+			report.Warnings = append(report.Warnings, fmt.Sprintf("Failed to get projections (%s)", err.Error()))
+		}
+	}
+
+	freeAgents := findFreeAgents(players, rosters, report.Positions, position)
+	for i := range freeAgents {
+		agent := &freeAgents[i]
+		for _, points := range recentPoints[agent.PlayerID] {
+			agent.RecentPoints += points
+			agent.WeeksPlayed++
+		}
+		agent.RecentPoints = math.Round(agent.RecentPoints*100) / 100
+		if agent.WeeksPlayed > 0 {
+			agent.RecentAverage = math.Round(agent.RecentPoints/float64(agent.WeeksPlayed)*100) / 100
+		}
+		agent.TrendingAdds = trendingAdds[agent.PlayerID]
+		if stats, projected := projections[agent.PlayerID]; projected {
+			projection := scorePlayerStats(stats, league.ScoringSettings)
+			agent.Projection = &projection
+		}
+		agent.Addresses = weaknessesAddressed(players[agent.PlayerID], report.Weaknesses)
+	}
+	rankFreeAgents(freeAgents, includeProjections)
+
+	report.TotalAvailable = len(freeAgents)
+	if len(freeAgents) > limit {
+		freeAgents = freeAgents[:limit]
+	}
+	report.FreeAgents = freeAgents
+
+	summary := fmt.Sprintf("%d available players at %s", report.TotalAvailable, strings.Join(report.Positions, ", "))
+	if len(report.FreeAgents) > 0 {
+		summary += fmt.Sprintf("; top pickup: %s (%s)", report.FreeAgents[0].Name, report.FreeAgents[0].Position)
+	}
+	if len(report.Weaknesses) > 0 {
+		summary += fmt.Sprintf("; roster %d is weak at %s", rosterID, strings.Join(report.Weaknesses, ", "))
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    report,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// findFreeAgents returns players on an NFL team who are on no roster, reserve or taxi
+// squad and who are eligible at one of the league's positions
+func findFreeAgents(players map[string]sleeper.Player, rosters []sleeper.Roster, positions []string, position string) []FreeAgent {
+	rostered := make(map[string]bool)
+	for _, roster := range rosters {
+		for _, group := range [][]string{roster.Players, roster.Reserve, roster.Taxi} {
+			for _, playerID := range group {
+				rostered[playerID] = true
+			}
+		}
+	}
+
+	usedPositions := make(map[string]bool)
+	for _, leaguePosition := range positions {
+		usedPositions[leaguePosition] = true
+	}
+
+	var freeAgents []FreeAgent
+	for playerID, player := range players {
+		if rostered[playerID] || player.Team == "" {
+			continue
+		}
+		if position != "" && !(playerFilters{Position: position}).matches(player) {
+			continue
+		}
+
+		eligible := false
+		for _, playerPosition := range playerPositions(player) {
+			if usedPositions[playerPosition] {
+				eligible = true
+				break
+			}
+		}
+		if !eligible {
+			continue
+		}
+
+		freeAgents = append(freeAgents, FreeAgent{
+			PlayerID:     playerID,
+			Name:         playerName(player),
+			Position:     player.Position,
+			Team:         player.Team,
+			InjuryStatus: player.InjuryStatus,
+		})
+	}
+	return freeAgents
+}
+
+// rankFreeAgents scores each player relative to the best available: recent points
+// carry the most weight, then trending adds, then projections when requested
+func rankFreeAgents(freeAgents []FreeAgent, includeProjections bool) {
+	maxRecent, maxTrending, maxProjection := 0.0, 0.0, 0.0
+	for _, agent := range freeAgents {
+		maxRecent = math.Max(maxRecent, agent.RecentPoints)
+		maxTrending = math.Max(maxTrending, float64(agent.TrendingAdds))
+		if agent.Projection != nil {
+			maxProjection = math.Max(maxProjection, *agent.Projection)
+		}
+	}
+
+	recentWeight, trendingWeight, projectionWeight := 0.6, 0.4, 0.0
+	if includeProjections {
+		recentWeight, trendingWeight, projectionWeight = 0.5, 0.3, 0.2
+	}
+	share := func(value, max float64) float64 {
+		if max <= 0 || value <= 0 {
+			return 0
+		}
+		return value / max
+	}
+
+	for i := range freeAgents {
+		agent := &freeAgents[i]
+		score := recentWeight*share(agent.RecentPoints, maxRecent) +
+			trendingWeight*share(float64(agent.TrendingAdds), maxTrending)
+		if agent.Projection != nil {
+			score += projectionWeight * share(*agent.Projection, maxProjection)
+		}
+		agent.Score = math.Round(score*1000) / 10
+	}
+
+	sort.Slice(freeAgents, func(i, j int) bool {
+		a, b := freeAgents[i], freeAgents[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.RecentPoints != b.RecentPoints {
+			return a.RecentPoints > b.RecentPoints
+		}
+		idA, errA := strconv.Atoi(a.PlayerID)
+		idB, errB := strconv.Atoi(b.PlayerID)
+		if errA == nil && errB == nil {
+			return idA < idB
+		}
+		return a.PlayerID < b.PlayerID
+	})
+}
+
+// rosterWeaknesses returns the league positions rated weak on a roster
+func rosterWeaknesses(roster *sleeper.Roster, players map[string]sleeper.Player, rosterPositions []string) []string {
+	var weaknesses []string
+	for _, analysis := range analyzeRosterStrength(roster, players, rosterPositions).PositionalBreakdown {
+		if analysis.Strength == "Weak" {
+			weaknesses = append(weaknesses, analysis.Position)
+		}
+	}
+	return weaknesses
+}
+
+// weaknessesAddressed returns the weak positions a player is eligible at
+func weaknessesAddressed(player sleeper.Player, weaknesses []string) []string {
+	var addressed []string
+	for _, weakness := range weaknesses {
+		for _, position := range playerPositions(player) {
+			if position == weakness {
+				addressed = append(addressed, weakness)
+				break
+			}
+		}
+	}
+	return addressed
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestFindFreeAgents(t *testing.T) {
+	rosters := []sleeper.Roster{
+		{RosterID: 1, OwnerID: "user1", Players: []string{"1", "2"}, Starters: []string{"1", "2"}},
+		{RosterID: 2, OwnerID: "user2", Players: []string{"3"}, Reserve: []string{"10"}, Taxi: []string{"11"}},
+	}
+	players := map[string]sleeper.Player{
+		"1":  {PlayerID: "1", FullName: "Rostered Quarterback", Position: "QB", Team: "KC"},
+		"3":  {PlayerID: "3", FullName: "Rostered Back", Position: "RB", Team: "SF"},
+		"10": {PlayerID: "10", FullName: "Reserve Back", Position: "RB", Team: "NYG"},
+		"11": {PlayerID: "11", FullName: "Taxi Back", Position: "RB", Team: "NYG"},
+		"20": {PlayerID: "20", FullName: "Dropped Back", Position: "RB", Team: "NYJ"},
+		"21": {PlayerID: "21", FullName: "Waiver Receiver", Position: "WR", Team: "DAL"},
+		"22": {PlayerID: "22", FullName: "Unused Kicker", Position: "K", Team: "KC"},
+		"23": {PlayerID: "23", FullName: "Unsigned Back", Position: "RB"},
+		"24": {PlayerID: "24", FullName: "Trending End", Position: "TE", Team: "BUF"},
+	}
+
+	positions := leaguePositions([]string{"QB", "RB", "RB", "WR", "FLEX", "BN", "BN", "IR"})
+	expectedPositions := []string{"QB", "RB", "WR", "TE"}
+	if len(positions) != len(expectedPositions) {
+		t.Fatalf("Expected positions %v, got %v", expectedPositions, positions)
+	}
+	for i, position := range expectedPositions {
+		if positions[i] != position {
+			t.Errorf("Expected position %d to be %s, got %s", i, position, positions[i])
+		}
+	}
+
+	available := make(map[string]bool)
+	for _, agent := range findFreeAgents(players, rosters, positions, "") {
+		available[agent.PlayerID] = true
+	}
+	for _, playerID := range []string{"20", "21", "24"} {
+		if !available[playerID] {
+			t.Errorf("Expected player %s to be available", playerID)
+		}
+	}
+	for _, playerID := range []string{"1", "3", "10", "11", "22", "23"} {
+		if available[playerID] {
+			t.Errorf("Expected player %s to be excluded", playerID)
+		}
+	}
+}
+
+func TestPlayerHandler_GetFreeAgents(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID:        leagueID,
+				Season:          "2024",
+				RosterPositions: []string{"QB", "RB", "RB", "WR", "FLEX", "BN", "BN", "IR"},
+				ScoringSettings: map[string]float64{"rec": 1, "rec_yd": 0.1, "rush_yd": 0.1},
+				Settings:        sleeper.LeagueSettings{LastScoredLeg: 3},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, OwnerID: "user1", Players: []string{"1", "2"}, Starters: []string{"1", "2"}},
+				{RosterID: 2, OwnerID: "user2", Players: []string{"3"}, Reserve: []string{"10"}, Taxi: []string{"11"}},
+			}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"1":  {PlayerID: "1", FullName: "Rostered Quarterback", Position: "QB", Team: "KC"},
+				"2":  {PlayerID: "2", FullName: "Rostered Receiver", Position: "WR", Team: "MIA"},
+				"3":  {PlayerID: "3", FullName: "Rostered Back", Position: "RB", Team: "SF"},
+				"10": {PlayerID: "10", FullName: "Reserve Back", Position: "RB", Team: "NYG"},
+				"11": {PlayerID: "11", FullName: "Taxi Back", Position: "RB", Team: "NYG"},
+				"20": {PlayerID: "20", FullName: "Dropped Back", Position: "RB", Team: "NYJ"},
+				"21": {PlayerID: "21", FullName: "Waiver Receiver", Position: "WR", Team: "DAL"},
+				"22": {PlayerID: "22", FullName: "Unused Kicker", Position: "K", Team: "KC"},
+				"23": {PlayerID: "23", FullName: "Unsigned Back", Position: "RB"},
+				"24": {PlayerID: "24", FullName: "Trending End", Position: "TE", Team: "BUF"},
+			}, nil
+		},
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			if week == 2 {
+				// Player 20 was rostered and scored 15 in this league before being dropped
+				return []sleeper.Matchup{{RosterID: 2, MatchupID: 1, Points: 15, PlayersPoints: map[string]float64{"20": 15}}}, nil
+			}
+			return []sleeper.Matchup{}, nil
+		},
+		GetWeeklyStatsFunc: func(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error) {
+			return map[string]sleeper.PlayerStats{
+				"20": {"rush_yd": 50},
+				"21": {"rec": 5, "rec_yd": 60},
+				"22": {"fgm": 3},
+			}, nil
+		},
+		GetTrendingPlayersFunc: func(sport, trendType string, hours, limit int) ([]sleeper.TrendingPlayer, error) {
+			return []sleeper.TrendingPlayer{{PlayerID: "24", Count: 800}, {PlayerID: "2", Count: 400}}, nil
+		},
+		GetProjectionsFunc: func(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error) {
+			return map[string]sleeper.PlayerStats{"24": {"rec": 6, "rec_yd": 70}}, nil
+		},
+	}
+	handler := NewPlayerHandler(mockClient, logger)
+
+	result, err := handler.HandleGetFreeAgents(context.Background(), map[string]interface{}{
+		"league_id":           "league1",
+		"roster_id":           float64(1),
+		"weeks":               float64(2),
+		"include_projections": true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool            `json:"success"`
+		Data    FreeAgentReport `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	report := response.Data
+	if len(report.RecentWeeks) != 2 || report.RecentWeeks[0] != 2 || report.ProjectionWeek != 4 {
+		t.Errorf("Expected recent weeks 2-3 and projections for week 4, got %v and %d", report.RecentWeeks, report.ProjectionWeek)
+	}
+	if report.TotalAvailable != 3 {
+		t.Fatalf("Expected 3 available players, got %d", report.TotalAvailable)
+	}
+
+	agents := make(map[string]FreeAgent)
+	for _, agent := range report.FreeAgents {
+		agents[agent.PlayerID] = agent
+	}
+
+	// Week 2 comes from the league's matchup, week 3 from stats under league scoring
+	if dropped := agents["20"]; dropped.RecentPoints != 20 || dropped.WeeksPlayed != 2 || dropped.RecentAverage != 10 {
+		t.Errorf("Expected the dropped back to have 20 points over 2 weeks, got %+v", dropped)
+	}
+	if receiver := agents["21"]; receiver.RecentPoints != 22 {
+		t.Errorf("Expected the waiver receiver to have 22 points, got %+v", receiver)
+	}
+	if end := agents["24"]; end.TrendingAdds != 800 || end.Projection == nil || *end.Projection != 13 {
+		t.Errorf("Expected the tight end to trend with a 13 point projection, got %+v", end)
+	}
+
+	// Roster 1 can't fill either running back slot; the flex can go to its receiver
+	if len(report.Weaknesses) != 1 || report.Weaknesses[0] != "RB" {
+		t.Errorf("Expected only an RB weakness, got %v", report.Weaknesses)
+	}
+	if addresses := agents["20"].Addresses; len(addresses) != 1 || addresses[0] != "RB" {
+		t.Errorf("Expected the dropped back to address RB, got %v", addresses)
+	}
+
+	for i := 1; i < len(report.FreeAgents); i++ {
+		if report.FreeAgents[i].Score > report.FreeAgents[i-1].Score {
+			t.Errorf("Expected free agents sorted by score, got %v before %v", report.FreeAgents[i-1], report.FreeAgents[i])
+		}
+	}
+}
+
+func TestPlayerHandler_GetFreeAgents_UnknownRoster(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{LeagueID: leagueID, Season: "2024", RosterPositions: []string{"QB", "BN"}}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{{RosterID: 1, OwnerID: "user1", Players: []string{"1"}}}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"1":  {PlayerID: "1", FullName: "Rostered Quarterback", Position: "QB", Team: "KC"},
+				"20": {PlayerID: "20", FullName: "Free Quarterback", Position: "QB", Team: "NYJ"},
+			}, nil
+		},
+	}
+	handler := NewPlayerHandler(mockClient, logger)
+
+	result, err := handler.HandleGetFreeAgents(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(9),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for a roster not in the league")
+	}
+}
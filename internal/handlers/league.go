@@ -2,7 +2,8 @@ package handlers
 
 import (
 	"context"
-	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
@@ -22,11 +23,12 @@ type LeagueInfoArgs struct {
 
 // LeagueStandingsArgs represents the parameters for the get_league_standings tool
 type LeagueStandingsArgs struct {
-	LeagueID       string   `json:"league_id"`
-	TiebreakOrder  []string `json:"tiebreak_order,omitempty"`
-	CustomMetrics  map[string]interface{} `json:"custom_metrics,omitempty"`
-	Instructions   string   `json:"instructions,omitempty"`
-	Mode           string   `json:"mode,omitempty"`
+	LeagueID      string                 `json:"league_id"`
+	TiebreakOrder []string               `json:"tiebreak_order,omitempty"`
+	CustomMetrics map[string]interface{} `json:"custom_metrics,omitempty"`
+	Instructions  string                 `json:"instructions,omitempty"`
+	Mode          string                 `json:"mode,omitempty"`
+	Source        string                 `json:"source,omitempty"`
 }
 
 // TiebreakerType represents different tiebreaker methods
@@ -54,38 +56,44 @@ type MatchupsArgs struct {
 
 // StandingEntry represents a team's standing in the league
 type StandingEntry struct {
-	RosterID           int                    `json:"roster_id"`
-	OwnerID            string                 `json:"owner_id"`
-	DisplayName        string                 `json:"display_name"`
-	TeamName           string                 `json:"team_name"`
-	Wins               int                    `json:"wins"`
-	Losses             int                    `json:"losses"`
-	Ties               int                    `json:"ties"`
-	PointsFor          float64                `json:"points_for"`
-	PointsAgainst      float64                `json:"points_against"`
-	Rank               int                    `json:"rank"`
-	Division           int                    `json:"division,omitempty"`
-	PlayoffSeed        int                    `json:"playoff_seed,omitempty"`
-	PlayoffOutcome     string                 `json:"playoff_outcome,omitempty"`     // "champion", "runner_up", "third_place", "fourth_place", "quarterfinal_loss", "no_playoffs"
-	RegularSeasonRank  int                    `json:"regular_season_rank,omitempty"` // Original regular season ranking
-	CustomMetrics      map[string]interface{} `json:"custom_metrics,omitempty"`
-	TiebreakerNotes    string                 `json:"tiebreaker_notes,omitempty"`
-	HeadToHeadWins     map[int]int            `json:"head_to_head_wins,omitempty"`
-	RandomTiebreakerID string                 `json:"random_tiebreaker_id,omitempty"` // For random tiebreaker consistency
+	RosterID             int                    `json:"roster_id"`
+	OwnerID              string                 `json:"owner_id"`
+	DisplayName          string                 `json:"display_name"`
+	TeamName             string                 `json:"team_name"`
+	Wins                 int                    `json:"wins"`
+	Losses               int                    `json:"losses"`
+	Ties                 int                    `json:"ties"`
+	PointsFor            float64                `json:"points_for"`
+	PointsAgainst        float64                `json:"points_against"`
+	Rank                 int                    `json:"rank"`
+	Division             int                    `json:"division,omitempty"`
+	PlayoffSeed          int                    `json:"playoff_seed,omitempty"`
+	SleeperPlayoffSeed   int                    `json:"sleeper_playoff_seed,omitempty"` // Seed as reported by Sleeper, for cross-checking
+	SeedType             string                 `json:"seed_type,omitempty"`            // "division_winner", "at_large", "points_for_wildcard"
+	PlayoffBye           bool                   `json:"playoff_bye,omitempty"`
+	SeedingNotes         string                 `json:"seeding_notes,omitempty"`
+	PlayoffOutcome       string                 `json:"playoff_outcome,omitempty"`     // "champion", "runner_up", "third_place", "fourth_place", "quarterfinal_loss", "no_playoffs"
+	RegularSeasonRank    int                    `json:"regular_season_rank,omitempty"` // Original regular season ranking
+	CustomMetrics        map[string]interface{} `json:"custom_metrics,omitempty"`
+	TiebreakerNotes      string                 `json:"tiebreaker_notes,omitempty"`
+	HeadToHeadWins       map[int]int            `json:"head_to_head_wins,omitempty"`
+	RandomTiebreakerID   string                 `json:"random_tiebreaker_id,omitempty"`   // For random tiebreaker consistency
+	RandomTiebreakerSeed string                 `json:"random_tiebreaker_seed,omitempty"` // Published seed the random tiebreaker IDs derive from
 }
 
 // PlayoffBracket represents the playoff bracket structure and results
 type PlayoffBracket struct {
-	QuarterfinalsWeek int                        `json:"quarterfinals_week"`
-	SemifinalsWeek    int                        `json:"semifinals_week"`
-	ChampionshipWeek  int                        `json:"championship_week"`
-	ThirdPlaceWeek    int                        `json:"third_place_week"`
-	HasThirdPlace     bool                       `json:"has_third_place"`
-	QuarterFinals     []PlayoffMatchup           `json:"quarterfinals"`
-	SemiFinals        []PlayoffMatchup           `json:"semifinals"`
-	Championship      *PlayoffMatchup            `json:"championship"`
-	ThirdPlace        *PlayoffMatchup            `json:"third_place"`
-	PlayoffTeams      map[int]int                `json:"playoff_teams"` // RosterID -> PlayoffSeed
+	QuarterfinalsWeek int              `json:"quarterfinals_week"`
+	SemifinalsWeek    int              `json:"semifinals_week"`
+	ChampionshipWeek  int              `json:"championship_week"`
+	ThirdPlaceWeek    int              `json:"third_place_week"`
+	HasThirdPlace     bool             `json:"has_third_place"`
+	QuarterFinals     []PlayoffMatchup `json:"quarterfinals"`
+	SemiFinals        []PlayoffMatchup `json:"semifinals"`
+	Championship      *PlayoffMatchup  `json:"championship"`
+	ThirdPlace        *PlayoffMatchup  `json:"third_place"`
+	PlayoffTeams      map[int]int      `json:"playoff_teams"` // RosterID -> PlayoffSeed
+	Seeding           *PlayoffSeeding  `json:"seeding,omitempty"`
 }
 
 // PlayoffMatchup represents a single playoff game
@@ -113,9 +121,10 @@ type PlayoffStructure struct {
 
 // LeagueHandler handles league-related MCP tools
 type LeagueHandler struct {
-	client sleeper.Client
-	logger *logrus.Logger
-	config *config.LeagueConfig
+	client   sleeper.Client
+	logger   *logrus.Logger
+	config   *config.LeagueConfig
+	schedule *scheduleLoader
 }
 
 // NewLeagueHandler creates a new league handler
@@ -141,9 +150,10 @@ func NewLeagueHandler(client sleeper.Client, logger *logrus.Logger) *LeagueHandl
 	}
 	
 	return &LeagueHandler{
-		client: client,
-		logger: logger,
-		config: leagueConfig,
+		client:   client,
+		logger:   logger,
+		config:   leagueConfig,
+		schedule: newScheduleLoader(logger),
 	}
 }
 
@@ -201,7 +211,6 @@ func (h *LeagueHandler) HandleGetLeagueInfo(ctx context.Context, args map[string
 		Metadata: sleeper.Metadata{
 			Timestamp:    time.Now(),
 			Source:       "sleeper_api",
-			CacheHit:     false,
 			APICallsUsed: 1,
 			LeagueID:     leagueID,
 		},
@@ -268,6 +277,11 @@ func (h *LeagueHandler) GetLeagueStandingsTool() mcp.Tool {
 					"description": "Standings mode: 'regular_season' or 'final' (default: regular_season)",
 					"required":    false,
 				},
+				"source": map[string]interface{}{
+					"type":        "string",
+					"description": "Where records come from: 'rosters' (Sleeper's cumulative totals) or 'matchups' (recomputed week by week, see audit_standings). Default: rosters",
+					"required":    false,
+				},
 			},
 		},
 	}
@@ -319,9 +333,114 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		}
 	}
 	
+	// Parse optional record source
+	source := ""
+	if sourceRaw, exists := args["source"]; exists {
+		if str, ok := sourceRaw.(string); ok {
+			source = str
+		}
+	}
+	
+	standings, apiCallsUsed, err := h.computeStandings(leagueID, standingsRequest{
+		TiebreakOrder: tiebreakOrder,
+		CustomMetrics: customMetrics,
+		Instructions:  instructions,
+		Mode:          mode,
+		Source:        source,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to compute league standings")
+		
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get league standings: %s", err.Error()),
+				},
+			},
+			IsError: true,
+		}, nil
+	}
+	
+	// Create response
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    standings,
+		Summary: fmt.Sprintf("League standings for %d teams - Leader: %s (%d-%d, %.1f pts)", 
+			len(standings), standings[0].DisplayName, standings[0].Wins, standings[0].Losses, standings[0].PointsFor),
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCallsUsed,
+			LeagueID:     leagueID,
+		},
+	}
+	
+	// Convert to JSON string for MCP response
+	jsonResponse, err := formatJSONResponse(response)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to format response")
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Type: "text",
+					Text: fmt.Sprintf("Error formatting response: %s", err.Error()),
+				},
+			},
+			IsError: true,
+		}, nil
+	}
+	
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Type: "text",
+				Text: jsonResponse,
+			},
+		},
+	}, nil
+}
+
+// standingsRequest holds the options for a standings calculation
+type standingsRequest struct {
+	TiebreakOrder []string
+	CustomMetrics map[string]interface{}
+	Instructions  string
+	Mode          string // "regular_season" or "final"
+	Source        string // "rosters" or "matchups"
+
+	// ConfigLeagueID is the league whose configuration applies when leagueID has
+	// none of its own, so past seasons can follow the current league's rules
+	ConfigLeagueID string
+	
+	// Records replaces the league's records and head-to-head results, e.g. with a
+	// season replayed under other scoring. Source is ignored when set.
+	Records map[int]*RecomputedRecord
+}
+
+// computeStandings builds ranked standings for a league, applying the league's
+// configuration, tiebreakers, playoff seeding and (in final mode) playoff results.
+// It returns the standings and the number of API calls used.
+func (h *LeagueHandler) computeStandings(leagueID string, req standingsRequest) ([]StandingEntry, int, error) {
+	tiebreakOrder := req.TiebreakOrder
+	customMetrics := req.CustomMetrics
+	instructions := req.Instructions
+	mode := req.Mode
+	if mode == "" {
+		mode = "regular_season"
+	}
+	source := req.Source
+	
+	configLeagueID := leagueID
+	if h.config != nil && req.ConfigLeagueID != "" {
+		if _, exists := h.config.Leagues[leagueID]; !exists {
+			configLeagueID = req.ConfigLeagueID
+		}
+	}
+	
 	// Check for league-specific configuration
-	if h.config != nil && h.config.HasCustomStandings(leagueID) {
-		leagueSettings := h.config.GetLeagueSettings(leagueID)
+	if h.config != nil && h.config.HasCustomStandings(configLeagueID) {
+		leagueSettings := h.config.GetLeagueSettings(configLeagueID)
 		
 		// Apply league configuration if not explicitly overridden
 		if instructions == "" && leagueSettings.Custom.Instructions != "" {
@@ -333,6 +452,14 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 			tiebreakOrder = leagueSettings.Custom.TiebreakOrder
 			h.logger.WithField("league_id", leagueID).Info("Applied league-specific tiebreaker order")
 		}
+		
+		if source == "" && leagueSettings.Custom.StandingsSource != "" {
+			source = leagueSettings.Custom.StandingsSource
+			h.logger.WithField("league_id", leagueID).Info("Applied league-specific standings source")
+		}
+	}
+	if source == "" {
+		source = "rosters"
 	}
 	
 	// Get league information to check playoff seed type
@@ -344,33 +471,13 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 	// Get league rosters for standings calculation
 	rosters, err := h.client.GetLeagueRosters(leagueID)
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to get league rosters")
-		
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Type: "text",
-					Text: fmt.Sprintf("Failed to get league standings: %s", err.Error()),
-				},
-			},
-			IsError: true,
-		}, nil
+		return nil, 0, fmt.Errorf("failed to get league rosters: %w", err)
 	}
 
 	// Get league users for team owner information
 	users, err := h.client.GetLeagueUsers(leagueID)
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to get league users")
-		
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Type: "text",
-					Text: fmt.Sprintf("Failed to get league users: %s", err.Error()),
-				},
-			},
-			IsError: true,
-		}, nil
+		return nil, 0, fmt.Errorf("failed to get league users: %w", err)
 	}
 	
 	// Create user map for quick lookup
@@ -387,8 +494,8 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 			Wins:          roster.Settings.Wins,
 			Losses:        roster.Settings.Losses,
 			Ties:          roster.Settings.Ties,
-			PointsFor:     roster.Settings.FPTS,
-			PointsAgainst: roster.Settings.FPTSAgainst,
+			PointsFor:     rosterPointsFor(roster.Settings),
+			PointsAgainst: rosterPointsAgainst(roster.Settings),
 			Division:      roster.Settings.Division,
 			PlayoffSeed:   roster.Settings.PlayoffSeed,
 		}
@@ -396,6 +503,7 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		// Add user information if available
 		if user, exists := userMap[roster.OwnerID]; exists {
 			entry.DisplayName = user.DisplayName
+			entry.TeamName = user.Metadata.TeamName
 		}
 		
 		// Add custom metrics if provided
@@ -411,6 +519,17 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		standings = append(standings, entry)
 	}
 	
+	// Replace Sleeper's cumulative totals with records rebuilt from weekly matchups
+	apiCallsUsed := 3
+	if req.Records != nil {
+		applyRecomputedRecords(standings, req.Records)
+	} else if source == "matchups" {
+		records, _, _, warnings, calls := h.recomputeRecordsFromMatchups(leagueID, league, 0)
+		h.logAuditWarnings(leagueID, warnings)
+		applyRecomputedRecords(standings, records)
+		apiCallsUsed += calls
+	}
+	
 	// Determine tiebreaker order from instructions, explicit order, or league settings
 	effectiveTiebreakOrder := getTiebreakOrder(tiebreakOrder, league)
 	if instructions != "" {
@@ -420,7 +539,9 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 	// Calculate head-to-head records if needed
 	var headToHeadMatrix map[int]map[int]int
 	needsHeadToHead := containsTiebreaker(effectiveTiebreakOrder, "head_to_head")
-	if needsHeadToHead {
+	if needsHeadToHead && req.Records != nil {
+		headToHeadMatrix = headToHeadFromRecords(req.Records)
+	} else if needsHeadToHead {
 		headToHeadMatrix, err = h.calculateHeadToHeadMatrix(leagueID, mode)
 		if err != nil {
 			h.logger.WithError(err).Warn("Failed to calculate head-to-head matrix, skipping head-to-head tiebreaker")
@@ -437,12 +558,27 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		}
 	}
 	
-	// Apply random tiebreaker IDs if needed
-	h.applyRandomTiebreaker(standings, effectiveTiebreakOrder)
+	// Apply seeded random tiebreaker IDs if needed
+	randomSeed := h.randomTiebreakerSeed(leagueID, league)
+	h.applyRandomTiebreaker(standings, effectiveTiebreakOrder, randomSeed)
 	
 	// Sort standings using flexible tiebreaker system
 	standings = sortStandingsWithTiebreakers(standings, effectiveTiebreakOrder, customMetrics, headToHeadMatrix)
 	
+	// Seed the playoff field using the league's seeding policy
+	var seedingPolicy config.PlayoffSeeding
+	if h.config != nil {
+		seedingPolicy = h.config.GetLeagueSettings(configLeagueID).Playoffs
+	}
+	seeding := computePlayoffSeeding(standings, league, seedingPolicy)
+	applySeedingToStandings(standings, seeding)
+	if len(seeding.Mismatches) > 0 && req.Records == nil {
+		h.logger.WithFields(logrus.Fields{
+			"league_id":  leagueID,
+			"mismatches": len(seeding.Mismatches),
+		}).Warn("Computed playoff seeds differ from Sleeper playoff_seed")
+	}
+	
 	// For final mode, process playoff bracket and calculate final standings
 	if mode == "final" && league != nil {
 		// First, get regular season standings (preserve current standings as regular season)
@@ -450,7 +586,7 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		copy(regularSeasonStandings, standings)
 		
 		// Process playoff bracket
-		bracket, err := h.processPlayoffBracket(leagueID, league, regularSeasonStandings)
+		bracket, err := h.processPlayoffBracket(leagueID, league, regularSeasonStandings, seeding)
 		if err != nil {
 			h.logger.WithError(err).Warn("Failed to process playoff bracket, using regular season standings")
 		} else {
@@ -467,9 +603,17 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 	
 	// Add notes about tiebreakers used
 	tiebreakerNotes := fmt.Sprintf("Tiebreakers applied: %v", effectiveTiebreakOrder)
+	if req.Records != nil {
+		tiebreakerNotes += " (Records replayed from weekly results)"
+	} else if source == "matchups" {
+		tiebreakerNotes += " (Records recomputed from weekly matchups)"
+	}
 	if league != nil && league.Settings.PlayoffSeedType != 0 {
 		tiebreakerNotes += fmt.Sprintf(" (League playoff_seed_type: %d)", league.Settings.PlayoffSeedType)
 	}
+	if containsTiebreaker(effectiveTiebreakOrder, "random") {
+		tiebreakerNotes += fmt.Sprintf(" (Random tiebreaker seed: %q)", randomSeed)
+	}
 	if mode == "final" {
 		tiebreakerNotes += " (Final standings based on playoff results)"
 	}
@@ -480,44 +624,7 @@ func (h *LeagueHandler) HandleGetLeagueStandings(ctx context.Context, args map[s
 		standings[i].TiebreakerNotes = tiebreakerNotes
 	}
 	
-	// Create response
-	response := sleeper.APIResponse{
-		Success: true,
-		Data:    standings,
-		Summary: fmt.Sprintf("League standings for %d teams - Leader: %s (%d-%d, %.1f pts)", 
-			len(standings), standings[0].DisplayName, standings[0].Wins, standings[0].Losses, standings[0].PointsFor),
-		Metadata: sleeper.Metadata{
-			Timestamp:    time.Now(),
-			Source:       "sleeper_api",
-			CacheHit:     false,
-			APICallsUsed: 3,
-			LeagueID:     leagueID,
-		},
-	}
-	
-	// Convert to JSON string for MCP response
-	jsonResponse, err := formatJSONResponse(response)
-	if err != nil {
-		h.logger.WithError(err).Error("Failed to format response")
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Type: "text",
-					Text: fmt.Sprintf("Error formatting response: %s", err.Error()),
-				},
-			},
-			IsError: true,
-		}, nil
-	}
-	
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			&mcp.TextContent{
-				Type: "text",
-				Text: jsonResponse,
-			},
-		},
-	}, nil
+	return standings, apiCallsUsed, nil
 }
 
 // GetLeagueUsersTool returns the MCP tool definition for get_league_users  
@@ -572,7 +679,6 @@ func (h *LeagueHandler) HandleGetLeagueUsers(ctx context.Context, args map[strin
 		Metadata: sleeper.Metadata{
 			Timestamp:    time.Now(),
 			Source:       "sleeper_api",
-			CacheHit:     false,
 			APICallsUsed: 1,
 			LeagueID:     leagueID,
 		},
@@ -607,7 +713,7 @@ func (h *LeagueHandler) HandleGetLeagueUsers(ctx context.Context, args map[strin
 func (h *LeagueHandler) GetMatchupsTool() mcp.Tool {
 	return mcp.Tool{
 		Name:        "get_matchups",
-		Description: "Get matchup information for a specific week including scores and rosters",
+		Description: "Get a week's matchups paired head-to-head with owner and team names, each starter's position, name and points, bench points, margin of victory, and the weekly high and low scores",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -662,16 +768,25 @@ func (h *LeagueHandler) HandleGetMatchups(ctx context.Context, args map[string]i
 		}, nil
 	}
 	
+	// Pair matchups and join them to owners and players
+	matchupCtx := h.loadMatchupContext(leagueID, true)
+	weekly := buildWeeklyMatchups(week, matchups, matchupCtx)
+	
+	summary := fmt.Sprintf("Found %d matchups for week %d", len(weekly.Matchups), week)
+	if weekly.HighScore != nil && weekly.LowScore != nil {
+		summary += fmt.Sprintf(" - high score: %s (%.2f), low score: %s (%.2f)",
+			weekly.HighScore.DisplayName, weekly.HighScore.Points, weekly.LowScore.DisplayName, weekly.LowScore.Points)
+	}
+	
 	// Create response
 	response := sleeper.APIResponse{
 		Success: true,
-		Data:    matchups,
-		Summary: fmt.Sprintf("Found %d matchups for week %d", len(matchups), week),
+		Data:    weekly,
+		Summary: summary,
 		Metadata: sleeper.Metadata{
 			Timestamp:    time.Now(),
 			Source:       "sleeper_api",
-			CacheHit:     false,
-			APICallsUsed: 1,
+			APICallsUsed: 1 + matchupCtx.apiCalls,
 			LeagueID:     leagueID,
 		},
 	}
@@ -727,6 +842,24 @@ func getTiebreakOrder(userTiebreakOrder []string, league *sleeper.League) []stri
 	return []string{"wins", "points_for", "points_against"}
 }
 
+// leagueTiebreakOrder returns the tiebreakers a league's standings use without
+// overrides: the configured order and instructions, then the league's settings
+func (h *LeagueHandler) leagueTiebreakOrder(leagueID string, league *sleeper.League) []string {
+	var tiebreakOrder []string
+	var instructions string
+	if h.config != nil && h.config.HasCustomStandings(leagueID) {
+		leagueSettings := h.config.GetLeagueSettings(leagueID)
+		tiebreakOrder = leagueSettings.Custom.TiebreakOrder
+		instructions = leagueSettings.Custom.Instructions
+	}
+	
+	effectiveTiebreakOrder := getTiebreakOrder(tiebreakOrder, league)
+	if instructions != "" {
+		effectiveTiebreakOrder = parseInstructionsToTiebreakOrder(instructions, effectiveTiebreakOrder)
+	}
+	return effectiveTiebreakOrder
+}
+
 // sortStandingsWithTiebreakers sorts standings using the specified tiebreaker order
 func sortStandingsWithTiebreakers(standings []StandingEntry, tiebreakOrder []string, customMetrics map[string]interface{}, headToHeadMatrix map[int]map[int]int) []StandingEntry {
 	// Create a copy to sort
@@ -944,6 +1077,10 @@ func compareValues(a, b interface{}) int {
 			}
 			return 0
 		}
+	case string:
+		if v2, ok := b.(string); ok {
+			return strings.Compare(v1, v2)
+		}
 	}
 	return 0
 }
@@ -1091,23 +1228,21 @@ func (h *LeagueHandler) calculateHeadToHeadMatrix(leagueID, mode string) (map[in
 }
 
 // processPlayoffBracket analyzes playoff bracket results and determines final standings
-func (h *LeagueHandler) processPlayoffBracket(leagueID string, league *sleeper.League, regularSeasonStandings []StandingEntry) (*PlayoffBracket, error) {
+func (h *LeagueHandler) processPlayoffBracket(leagueID string, league *sleeper.League, regularSeasonStandings []StandingEntry, seeding *PlayoffSeeding) (*PlayoffBracket, error) {
 	bracket := &PlayoffBracket{
 		PlayoffTeams:  make(map[int]int),
 		HasThirdPlace: true, // Default assumption
+		Seeding:       seeding,
 	}
 	
-	// Determine playoff teams based on league settings and regular season standings
-	playoffTeams := 6 // Default
-	if league.Settings.PlayoffTeams > 0 {
-		playoffTeams = league.Settings.PlayoffTeams
+	// Fall back to seeding the top teams by record when no seeding was computed
+	if bracket.Seeding == nil {
+		bracket.Seeding = computePlayoffSeeding(regularSeasonStandings, league, config.PlayoffSeeding{})
 	}
 	
-	// Map top teams to playoff seeds based on regular season standings
-	for i, team := range regularSeasonStandings {
-		if i < playoffTeams {
-			bracket.PlayoffTeams[team.RosterID] = i + 1 // 1-indexed seeds
-		}
+	// Map seeded teams into the bracket
+	for rosterID, seed := range bracket.Seeding.Seeds {
+		bracket.PlayoffTeams[rosterID] = seed
 	}
 	
 	// Get authoritative bracket data from Sleeper API
@@ -1121,6 +1256,12 @@ func (h *LeagueHandler) processPlayoffBracket(leagueID string, league *sleeper.L
 		return nil, fmt.Errorf("failed to process bracket from API: %w", err)
 	}
 	
+	// Replay the bracket against the seeded pairings (reseeding if configured)
+	trackSeededRounds(bracket.Seeding, winnersBracket)
+	for _, note := range bracket.Seeding.Notes {
+		h.logger.WithField("league_id", leagueID).Info(note)
+	}
+	
 	return bracket, nil
 }
 
@@ -1189,6 +1330,11 @@ func (h *LeagueHandler) processBracketFromAPI(bracket *PlayoffBracket, winnersBr
 	
 	// Process other rounds for quarterfinals and semifinals
 	for _, game := range winnersBracket {
+		// Placement games before the final round (e.g. the 5th place game) are not bracket rounds
+		if game.Round < maxRound && game.PlayoffWeek != nil {
+			continue
+		}
+		
 		playoffMatchup := PlayoffMatchup{
 			Week:        15 + game.Round - 1, // Rough mapping: Round 1=Week 15, etc.
 			MatchupID:   game.MatchupID,
@@ -1397,17 +1543,17 @@ func (h *LeagueHandler) shouldSwapFinalStandings(a, b StandingEntry) bool {
 	
 	// If different playoff outcomes, use playoff outcome priority
 	if priorityA != priorityB {
-		return priorityA > priorityB // Higher priority (worse outcome) should come later
+		return priorityA < priorityB // Better outcomes come first
 	}
 	
 	// Same playoff outcome - use regular season tiebreakers
 	switch a.PlayoffOutcome {
 	case "quarterfinal_loss":
 		// Quarterfinal losers ranked by regular season record
-		return a.RegularSeasonRank > b.RegularSeasonRank
+		return a.RegularSeasonRank < b.RegularSeasonRank
 	case "no_playoffs":
 		// Non-playoff teams ranked by regular season record
-		return a.RegularSeasonRank > b.RegularSeasonRank
+		return a.RegularSeasonRank < b.RegularSeasonRank
 	default:
 		// Championship, runner-up, third place, fourth place are determined by games
 		// No additional sorting needed within these categories
@@ -1900,35 +2046,41 @@ func (h *LeagueHandler) verifyTwoWeekChampionship(leagueID string, playoffTeams
 	return false
 }
 
-// generateRandomTiebreakerID generates a consistent random ID for tiebreaker purposes
-func generateRandomTiebreakerID() string {
-	bytes := make([]byte, 8)
-	if _, err := rand.Read(bytes); err != nil {
-		// Fallback to timestamp-based ID if crypto/rand fails
-		return fmt.Sprintf("tb_%d", time.Now().UnixNano())
-	}
-	return fmt.Sprintf("tb_%x", bytes)
-}
-
-// applyRandomTiebreaker assigns random tiebreaker IDs to teams that need them
-func (h *LeagueHandler) applyRandomTiebreaker(standings []StandingEntry, tiebreakOrder []string) {
-	// Only apply random tiebreaker if it's in the tiebreak order
-	hasRandomTiebreaker := false
-	for _, tb := range tiebreakOrder {
-		if tb == "random" {
-			hasRandomTiebreaker = true
-			break
+// randomTiebreakerSeed returns the seed used for the coin-flip tiebreaker.
+// A seed configured in the league's own entry wins; otherwise it is derived from
+// the league ID and season so the same league always flips the same way. Seeds in
+// default_settings are ignored so leagues never share a seed.
+func (h *LeagueHandler) randomTiebreakerSeed(leagueID string, league *sleeper.League) string {
+	if h.config != nil {
+		if seed := h.config.Leagues[leagueID].Custom.RandomSeed; seed != "" {
+			return seed
 		}
 	}
 	
-	if !hasRandomTiebreaker {
+	if league != nil && league.Season != "" {
+		return fmt.Sprintf("%s:%s", leagueID, league.Season)
+	}
+	
+	return leagueID
+}
+
+// generateRandomTiebreakerID derives a team's coin-flip value from the seed.
+// The value is the first 16 hex characters of sha256("<seed>:<roster_id>"),
+// so anyone with the published seed can reproduce the order.
+func generateRandomTiebreakerID(seed string, rosterID int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", seed, rosterID)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// applyRandomTiebreaker assigns seeded tiebreaker IDs to teams that need them
+func (h *LeagueHandler) applyRandomTiebreaker(standings []StandingEntry, tiebreakOrder []string, seed string) {
+	// Only apply random tiebreaker if it's in the tiebreak order
+	if !containsTiebreaker(tiebreakOrder, "random") {
 		return
 	}
 	
-	// Assign random IDs to teams that don't have them
 	for i := range standings {
-		if standings[i].RandomTiebreakerID == "" {
-			standings[i].RandomTiebreakerID = generateRandomTiebreakerID()
-		}
+		standings[i].RandomTiebreakerID = generateRandomTiebreakerID(seed, standings[i].RosterID)
+		standings[i].RandomTiebreakerSeed = seed
 	}
-}
\ No newline at end of file
+}
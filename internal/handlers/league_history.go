@@ -18,12 +18,20 @@ type LeagueHistoryArgs struct {
 	Seasons  int    `json:"seasons,omitempty"` // Number of seasons back to look (default: 10)
 }
 
+// Discovery methods for how a season was found
+const (
+	DiscoveryMethodCurrent          = "current"
+	DiscoveryMethodPreviousLeagueID = "previous_league_id"
+	DiscoveryMethodUserOverlap      = "user_overlap"
+)
+
 // SeasonData represents a league season
 type SeasonData struct {
-	Season   string          `json:"season"`
-	LeagueID string          `json:"league_id"`
-	League   *sleeper.League `json:"league"`
-	Users    []sleeper.User  `json:"users"`
+	Season          string          `json:"season"`
+	LeagueID        string          `json:"league_id"`
+	League          *sleeper.League `json:"league"`
+	Users           []sleeper.User  `json:"users"`
+	DiscoveryMethod string          `json:"discovery_method"` // "current", "previous_league_id", "user_overlap"
 }
 
 // LeagueGroup represents a multi-season league
@@ -32,6 +40,7 @@ type LeagueGroup struct {
 	Description string                `json:"description"`
 	Seasons     map[string]SeasonData `json:"seasons"`
 	CommonUsers []sleeper.User        `json:"common_users"`
+	apiCalls    int                   // API calls used during discovery
 }
 
 // DiscoverLeagueHistoryTool returns the MCP tool definition for discover_league_history
@@ -57,7 +66,7 @@ func (h *LeagueHandler) DiscoverLeagueHistoryTool() mcp.Tool {
 	}
 }
 
-// HandleDiscoverLeagueHistory discovers historical league IDs by finding leagues with overlapping membership
+// HandleDiscoverLeagueHistory discovers historical league IDs via previous_league_id links and membership overlap
 func (h *LeagueHandler) HandleDiscoverLeagueHistory(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
 	h.logger.WithField("args", args).Info("Handling discover_league_history")
 
@@ -93,11 +102,10 @@ func (h *LeagueHandler) HandleDiscoverLeagueHistory(ctx context.Context, args ma
 	response := sleeper.APIResponse{
 		Success: true,
 		Data:    leagueGroup,
-		Summary: fmt.Sprintf("Discovered %d seasons of league history", len(leagueGroup.Seasons)),
+		Summary: fmt.Sprintf("Discovered %d seasons of league history (%s)", len(leagueGroup.Seasons), discoveryMethodSummary(leagueGroup)),
 		Metadata: sleeper.Metadata{
 			Timestamp:    time.Now(),
 			Source:       "sleeper_api",
-			CacheHit:     false,
 			APICallsUsed: calculateAPICalls(leagueGroup),
 			LeagueID:     leagueID,
 		},
@@ -128,7 +136,9 @@ func (h *LeagueHandler) HandleDiscoverLeagueHistory(ctx context.Context, args ma
 	}, nil
 }
 
-// discoverLeagueHistory discovers historical leagues by finding leagues with overlapping membership
+// discoverLeagueHistory discovers historical leagues by walking the previous_league_id
+// chain, falling back to searching for leagues with overlapping membership where a
+// link is broken
 func (h *LeagueHandler) discoverLeagueHistory(currentLeagueID string, maxSeasons int) (*LeagueGroup, error) {
 	// Get current league info and users
 	currentLeague, err := h.client.GetLeague(currentLeagueID)
@@ -157,89 +167,146 @@ func (h *LeagueHandler) discoverLeagueHistory(currentLeagueID string, maxSeasons
 		Description: "Automatically discovered league history",
 		Seasons:     make(map[string]SeasonData),
 		CommonUsers: currentUsers,
+		apiCalls:    2,
 	}
 
 	// Add current season
 	leagueGroup.Seasons[currentSeason] = SeasonData{
-		Season:   currentSeason,
-		LeagueID: currentLeagueID,
-		League:   currentLeague,
-		Users:    currentUsers,
+		Season:          currentSeason,
+		LeagueID:        currentLeagueID,
+		League:          currentLeague,
+		Users:           currentUsers,
+		DiscoveryMethod: DiscoveryMethodCurrent,
+	}
+
+	// Walk the previous_league_id chain. Where the chain ends, because the link is
+	// missing or can't be followed, search the prior season for a league with the same
+	// members, which also finds leagues recreated each year instead of renewed.
+	earliest := leagueGroup.Seasons[currentSeason]
+	for len(leagueGroup.Seasons) <= maxSeasons {
+		previous, chainEnded := h.findPreviousSeason(earliest.League, leagueGroup)
+		if previous == nil && chainEnded {
+			earliestYear, _ := strconv.Atoi(earliest.Season)
+			searchSeason := fmt.Sprintf("%d", earliestYear-1)
+			previous = h.findSeasonByOverlap(currentUsers, searchSeason, leagueGroup)
+			if previous == nil {
+				h.logger.WithField("season", searchSeason).Info("No league found for season")
+			} else if _, exists := leagueGroup.Seasons[previous.Season]; exists {
+				previous = nil
+			}
+		}
+		if previous == nil {
+			break
+		}
+		leagueGroup.Seasons[previous.Season] = *previous
+		earliest = *previous
+	}
+
+	return leagueGroup, nil
+}
+
+// findPreviousSeason follows a league's previous_league_id to the prior season.
+// It returns nil when the prior season is already known, and reports the chain as
+// ended when there is no link or the prior league can't be loaded.
+func (h *LeagueHandler) findPreviousSeason(league *sleeper.League, leagueGroup *LeagueGroup) (*SeasonData, bool) {
+	if league == nil || league.PreviousLeagueID == "" || league.PreviousLeagueID == "0" {
+		return nil, true
+	}
+
+	previousLeague, err := h.client.GetLeague(league.PreviousLeagueID)
+	leagueGroup.apiCalls++
+	if err != nil {
+		h.logger.WithError(err).WithField("league_id", league.PreviousLeagueID).Warn("Failed to follow previous_league_id")
+		return nil, true
+	}
+	if previousLeague == nil || previousLeague.Season == "" {
+		h.logger.WithField("league_id", league.PreviousLeagueID).Warn("previous_league_id points to a league without a season")
+		return nil, true
+	}
+	if _, exists := leagueGroup.Seasons[previousLeague.Season]; exists {
+		return nil, false
+	}
+
+	previousUsers, err := h.client.GetLeagueUsers(previousLeague.LeagueID)
+	leagueGroup.apiCalls++
+	if err != nil {
+		h.logger.WithError(err).WithField("league_id", previousLeague.LeagueID).Warn("Failed to get users for previous league")
+		return nil, true
 	}
 
-	// Search previous seasons
-	currentYear, _ := strconv.Atoi(currentSeason)
-	for i := 1; i <= maxSeasons; i++ {
-		searchYear := currentYear - i
-		searchSeason := fmt.Sprintf("%d", searchYear)
+	h.logger.WithFields(logrus.Fields{
+		"league_id": previousLeague.LeagueID,
+		"season":    previousLeague.Season,
+	}).Info("Found historical league via previous_league_id")
+
+	return &SeasonData{
+		Season:          previousLeague.Season,
+		LeagueID:        previousLeague.LeagueID,
+		League:          previousLeague,
+		Users:           previousUsers,
+		DiscoveryMethod: DiscoveryMethodPreviousLeagueID,
+	}, false
+}
+
+// findSeasonByOverlap searches members' leagues in a season for one whose membership
+// overlaps the current league by at least 60%
+func (h *LeagueHandler) findSeasonByOverlap(currentUsers []sleeper.User, searchSeason string, leagueGroup *LeagueGroup) *SeasonData {
+	h.logger.WithField("season", searchSeason).Info("Searching for league in season")
 
-		h.logger.WithField("season", searchSeason).Info("Searching for league in season")
+	// Use first few users to search for leagues in this season
+	// We'll search with multiple users to increase chances of finding the league
+	searchUsers := currentUsers
+	if len(searchUsers) > 5 {
+		searchUsers = currentUsers[:5] // Limit to first 5 users to avoid too many API calls
+	}
 
-		// Use first few users to search for leagues in this season
-		// We'll search with multiple users to increase chances of finding the league
-		searchUsers := currentUsers
-		if len(searchUsers) > 5 {
-			searchUsers = currentUsers[:5] // Limit to first 5 users to avoid too many API calls
+	for _, user := range searchUsers {
+		userLeagues, err := h.client.GetUserLeagues(user.UserID, "nfl", searchSeason)
+		leagueGroup.apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithFields(logrus.Fields{
+				"user_id": user.UserID,
+				"season":  searchSeason,
+			}).Warn("Failed to get user leagues")
+			continue
 		}
 
-		var foundLeague *SeasonData
-		for _, user := range searchUsers {
-			userLeagues, err := h.client.GetUserLeagues(user.UserID, "nfl", searchSeason)
+		// Look for leagues with significant overlap with current users
+		for i := range userLeagues {
+			league := userLeagues[i]
+			leagueUsers, err := h.client.GetLeagueUsers(league.LeagueID)
+			leagueGroup.apiCalls++
 			if err != nil {
-				h.logger.WithError(err).WithFields(logrus.Fields{
-					"user_id": user.UserID,
-					"season":  searchSeason,
-				}).Warn("Failed to get user leagues")
 				continue
 			}
 
-			// Look for leagues with significant overlap with current users
-			for _, league := range userLeagues {
-				leagueUsers, err := h.client.GetLeagueUsers(league.LeagueID)
-				if err != nil {
-					continue
-				}
+			// Calculate overlap percentage
+			overlap := calculateUserOverlap(currentUsers, leagueUsers)
+			h.logger.WithFields(logrus.Fields{
+				"league_id": league.LeagueID,
+				"overlap":   overlap,
+				"season":    searchSeason,
+			}).Debug("Calculated user overlap")
 
-				// Calculate overlap percentage
-				overlap := calculateUserOverlap(currentUsers, leagueUsers)
+			// If 60%+ of users overlap, likely same league
+			if overlap >= 0.6 {
 				h.logger.WithFields(logrus.Fields{
 					"league_id": league.LeagueID,
 					"overlap":   overlap,
 					"season":    searchSeason,
-				}).Debug("Calculated user overlap")
-
-				// If 60%+ of users overlap, likely same league
-				if overlap >= 0.6 {
-					foundLeague = &SeasonData{
-						Season:   searchSeason,
-						LeagueID: league.LeagueID,
-						League:   &league,
-						Users:    leagueUsers,
-					}
-					h.logger.WithFields(logrus.Fields{
-						"league_id": league.LeagueID,
-						"overlap":   overlap,
-						"season":    searchSeason,
-					}).Info("Found historical league")
-					break
+				}).Info("Found historical league via user overlap")
+				return &SeasonData{
+					Season:          searchSeason,
+					LeagueID:        league.LeagueID,
+					League:          &league,
+					Users:           leagueUsers,
+					DiscoveryMethod: DiscoveryMethodUserOverlap,
 				}
 			}
-
-			if foundLeague != nil {
-				break
-			}
-		}
-
-		if foundLeague != nil {
-			leagueGroup.Seasons[searchSeason] = *foundLeague
-		} else {
-			h.logger.WithField("season", searchSeason).Info("No league found for season")
-			// Stop searching if we don't find a league for this season
-			break
 		}
 	}
 
-	return leagueGroup, nil
+	return nil
 }
 
 // calculateUserOverlap calculates the percentage of users that overlap between two user lists
@@ -271,10 +338,19 @@ func calculateUserOverlap(users1, users2 []sleeper.User) float64 {
 	return float64(overlap) / float64(minSize)
 }
 
-// calculateAPICalls estimates the number of API calls used in discovery
+// discoveryMethodSummary counts how many prior seasons each discovery method found
+func discoveryMethodSummary(leagueGroup *LeagueGroup) string {
+	counts := make(map[string]int)
+	for _, seasonData := range leagueGroup.Seasons {
+		counts[seasonData.DiscoveryMethod]++
+	}
+	return fmt.Sprintf("%d via previous_league_id, %d via user overlap",
+		counts[DiscoveryMethodPreviousLeagueID], counts[DiscoveryMethodUserOverlap])
+}
+
+// calculateAPICalls returns the number of API calls used in discovery
 func calculateAPICalls(leagueGroup *LeagueGroup) int {
-	// Rough estimate: 1 call per league + 1 call per league for users + user search calls
-	return len(leagueGroup.Seasons)*2 + 10 // +10 for user league searches
+	return leagueGroup.apiCalls
 }
 
 // GetLeagueHistoryTool returns the MCP tool definition for get_league_history
@@ -292,7 +368,7 @@ func (h *LeagueHandler) GetLeagueHistoryTool() mcp.Tool {
 				},
 				"include_standings": map[string]interface{}{
 					"type":        "boolean",
-					"description": "Include standings for each season (default: true)",
+					"description": "Include final standings, with playoff results, for each completed season (default: true)",
 					"required":    false,
 				},
 			},
@@ -337,21 +413,43 @@ func (h *LeagueHandler) HandleGetLeagueHistory(ctx context.Context, args map[str
 	history["seasons_found"] = len(seasons)
 	history["seasons"] = seasons
 
+	// Tie each season's rosters to stable owners
+	registry, warnings, apiCalls := h.loadOwnerRegistry(leagueGroup, leagueID)
+	apiCalls += calculateAPICalls(leagueGroup)
+	history["owners"] = registry.identities()
+	history["franchises"] = registry.franchises()
+	if len(warnings) > 0 {
+		history["warnings"] = warnings
+	}
+
 	// Add season details
 	seasonDetails := make(map[string]interface{})
 	for _, season := range seasons {
 		seasonData := leagueGroup.Seasons[season]
 		details := map[string]interface{}{
-			"league_id":   seasonData.LeagueID,
-			"league_name": seasonData.League.Name,
-			"status":      seasonData.League.Status,
-			"users_count": len(seasonData.Users),
+			"league_id":        seasonData.LeagueID,
+			"league_name":      seasonData.League.Name,
+			"status":           seasonData.League.Status,
+			"users_count":      len(seasonData.Users),
+			"discovery_method": seasonData.DiscoveryMethod,
 		}
 
 		if includeStandings && seasonData.League.Status == "complete" {
-			// Note: This would call the existing standings function
-			// For now, just indicate standings are available
-			details["standings_available"] = true
+			standings, calls, err := h.seasonFinalStandings(seasonData, leagueID)
+			apiCalls += calls
+			if err != nil {
+				h.logger.WithError(err).WithField("season", season).Warn("Failed to compute season standings")
+				details["standings_error"] = err.Error()
+			} else {
+				registry.applyToStandings(season, standings)
+				details["standings"] = standings
+				for _, entry := range standings {
+					if entry.PlayoffOutcome == "champion" {
+						details["champion"] = entry.DisplayName
+						break
+					}
+				}
+			}
 		}
 
 		seasonDetails[season] = details
@@ -366,8 +464,7 @@ func (h *LeagueHandler) HandleGetLeagueHistory(ctx context.Context, args map[str
 		Metadata: sleeper.Metadata{
 			Timestamp:    time.Now(),
 			Source:       "sleeper_api",
-			CacheHit:     false,
-			APICallsUsed: calculateAPICalls(leagueGroup),
+			APICallsUsed: apiCalls,
 			LeagueID:     leagueID,
 		},
 	}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLeagueHandler_DiscoverLeagueHistory_PreviousLeagueChain(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	users := []sleeper.User{{UserID: "user1"}, {UserID: "user2"}}
+	leagues := map[string]*sleeper.League{
+		"league2024": {LeagueID: "league2024", Season: "2024", PreviousLeagueID: "league2023"},
+		"league2023": {LeagueID: "league2023", Season: "2023", PreviousLeagueID: "deleted2022"},
+		"league2022": {LeagueID: "league2022", Season: "2022", PreviousLeagueID: "league2021"},
+		"league2021": {LeagueID: "league2021", Season: "2021", PreviousLeagueID: "0"},
+	}
+
+	userLeagueSearches := make(map[string]int)
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			league, exists := leagues[leagueID]
+			if !exists {
+				return nil, fmt.Errorf("league %s not found", leagueID)
+			}
+			return league, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return users, nil
+		},
+		GetUserLeaguesFunc: func(userID, sport, season string) ([]sleeper.League, error) {
+			userLeagueSearches[season]++
+			// The 2023 link is broken, so 2022 is only reachable by user overlap
+			if season == "2022" {
+				return []sleeper.League{*leagues["league2022"]}, nil
+			}
+			return []sleeper.League{}, nil
+		},
+	}
+
+	handler := NewLeagueHandler(mockClient, logger)
+	leagueGroup, err := handler.discoverLeagueHistory("league2024", 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"2024": DiscoveryMethodCurrent,
+		"2023": DiscoveryMethodPreviousLeagueID,
+		"2022": DiscoveryMethodUserOverlap,
+		"2021": DiscoveryMethodPreviousLeagueID,
+	}
+	if len(leagueGroup.Seasons) != len(expected) {
+		t.Fatalf("Expected %d seasons, got %d", len(expected), len(leagueGroup.Seasons))
+	}
+	for season, method := range expected {
+		if leagueGroup.Seasons[season].DiscoveryMethod != method {
+			t.Errorf("Expected season %s to be found via %s, got %q", season, method, leagueGroup.Seasons[season].DiscoveryMethod)
+		}
+	}
+
+	if userLeagueSearches["2023"] != 0 {
+		t.Errorf("Expected no user league searches for a season found via previous_league_id, got %d", userLeagueSearches["2023"])
+	}
+	if userLeagueSearches["2020"] == 0 {
+		t.Error("Expected the season before a league with no previous_league_id to be searched by user overlap")
+	}
+	if calculateAPICalls(leagueGroup) == 0 {
+		t.Error("Expected API calls to be counted during discovery")
+	}
+}
+
+func TestLeagueHandler_DiscoverLeagueHistory_NoPreviousLeague(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	// A league recreated each season instead of renewed has no previous_league_id
+	users := []sleeper.User{{UserID: "user1"}, {UserID: "user2"}, {UserID: "user3"}}
+	leagues := map[string]*sleeper.League{
+		"league2024": {LeagueID: "league2024", Season: "2024"},
+		"league2023": {LeagueID: "league2023", Season: "2023", PreviousLeagueID: "0"},
+	}
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return leagues[leagueID], nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return users, nil
+		},
+		GetUserLeaguesFunc: func(userID, sport, season string) ([]sleeper.League, error) {
+			if season == "2023" {
+				return []sleeper.League{*leagues["league2023"]}, nil
+			}
+			return []sleeper.League{}, nil
+		},
+	}
+
+	handler := NewLeagueHandler(mockClient, logger)
+	leagueGroup, err := handler.discoverLeagueHistory("league2024", 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(leagueGroup.Seasons) != 2 {
+		t.Fatalf("Expected 2 seasons, got %d", len(leagueGroup.Seasons))
+	}
+	if method := leagueGroup.Seasons["2023"].DiscoveryMethod; method != DiscoveryMethodUserOverlap {
+		t.Errorf("Expected 2023 to be found via %s, got %q", DiscoveryMethodUserOverlap, method)
+	}
+}
+
+func TestLeagueHandler_GetLeagueHistory_SeasonStandings(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(newCareersMockClient(), logger)
+
+	// Only the current league is configured; the 2023 season should follow it
+	handler.config = &config.LeagueConfig{
+		Leagues: map[string]config.LeagueSettings{
+			"league2024": {Custom: config.CustomStandings{Enabled: true, TiebreakOrder: []string{"wins", "points_against"}}},
+		},
+	}
+
+	result, err := handler.HandleGetLeagueHistory(context.Background(), map[string]interface{}{
+		"league_id": "league2024",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %s", result.Content[0].(*mcp.TextContent).Text)
+	}
+
+	var response struct {
+		Data struct {
+			SeasonDetails map[string]struct {
+				Standings      []StandingEntry `json:"standings"`
+				Champion       string          `json:"champion"`
+				StandingsError string          `json:"standings_error"`
+			} `json:"season_details"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	season2023 := response.Data.SeasonDetails["2023"]
+	if season2023.StandingsError != "" {
+		t.Fatalf("Unexpected standings error: %s", season2023.StandingsError)
+	}
+	if len(season2023.Standings) != 8 || season2023.Champion != "Player 5" {
+		t.Fatalf("Expected 8 standings entries with Player 5 as champion, got %d and %q", len(season2023.Standings), season2023.Champion)
+	}
+	if first := season2023.Standings[0]; first.RosterID != 5 || first.PlayoffOutcome != "champion" {
+		t.Errorf("Expected roster 5 to finish first as champion, got roster %d (%s)", first.RosterID, first.PlayoffOutcome)
+	}
+	if !strings.Contains(season2023.Standings[0].TiebreakerNotes, "points_against") {
+		t.Errorf("Expected the current league's tiebreakers to apply to 2023, got %q", season2023.Standings[0].TiebreakerNotes)
+	}
+
+	// Seasons still in progress have no final standings
+	if season2024 := response.Data.SeasonDetails["2024"]; len(season2024.Standings) != 0 || season2024.Champion != "" {
+		t.Errorf("Expected no standings for the in-progress season, got %d entries", len(season2024.Standings))
+	}
+}
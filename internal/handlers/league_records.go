@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// RecordEntry represents a single entry in the league record book
+type RecordEntry struct {
+	Value       float64 `json:"value"`
+	OwnerID     string  `json:"owner_id"`
+	DisplayName string  `json:"display_name,omitempty"`
+	Season      string  `json:"season"`
+	Week        int     `json:"week,omitempty"`
+	EndSeason   string  `json:"end_season,omitempty"` // Streaks: season and week the streak ended
+	EndWeek     int     `json:"end_week,omitempty"`
+	Opponent    string  `json:"opponent,omitempty"`
+	Score       string  `json:"score,omitempty"`  // e.g. "152.30-98.10"
+	Record      string  `json:"record,omitempty"` // W-L-T for season records
+	GameType    string  `json:"game_type,omitempty"`
+	Active      bool    `json:"active,omitempty"` // Streak is still running
+}
+
+// LeagueRecords represents the all-time league record book
+type LeagueRecords struct {
+	LeagueName         string        `json:"league_name"`
+	Seasons            []string      `json:"seasons"`
+	GamesScanned       int           `json:"games_scanned"`
+	HighestScores      []RecordEntry `json:"highest_scores"`
+	LowestScores       []RecordEntry `json:"lowest_scores"`
+	LargestMargins     []RecordEntry `json:"largest_margins"`
+	LongestWinStreaks  []RecordEntry `json:"longest_win_streaks"`
+	LongestLossStreaks []RecordEntry `json:"longest_loss_streaks"`
+	MostSeasonPoints   []RecordEntry `json:"most_season_points"`
+	BestRecords        []RecordEntry `json:"best_records"`
+	RuleChanges        []string      `json:"rule_changes,omitempty"` // Scoring and roster changes between seasons, for context
+	Warnings           []string      `json:"warnings,omitempty"`
+}
+
+// GetLeagueRecordsTool returns the MCP tool definition for get_league_records
+func (h *LeagueHandler) GetLeagueRecordsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_league_records",
+		Description: "Get the all-time league record book across every discovered season: highest and lowest scores, largest margins, longest win and loss streaks, most points in a season and best regular-season records",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Current league ID (will auto-discover historical seasons)",
+					"required":    true,
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of entries to return per record (default: 5)",
+					"required":    false,
+				},
+				"include_playoffs": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Include playoff and consolation games in single-game records (default: true)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetLeagueRecords handles the get_league_records tool call
+func (h *LeagueHandler) HandleGetLeagueRecords(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_league_records")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	limit := 5
+	if limitFloat, ok := args["limit"].(float64); ok && limitFloat > 0 {
+		limit = int(limitFloat)
+	}
+
+	includePlayoffs := true
+	if includeBool, ok := args["include_playoffs"].(bool); ok {
+		includePlayoffs = includeBool
+	}
+
+	history, err := h.loadLeagueGameHistory(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to load league history")
+		return toolErrorResult(fmt.Sprintf("Failed to load league history: %s", err.Error())), nil
+	}
+
+	records := buildLeagueRecords(history, limit, includePlayoffs)
+
+	summary := fmt.Sprintf("League records across %d seasons (%d games)", len(records.Seasons), records.GamesScanned)
+	if len(records.HighestScores) > 0 {
+		top := records.HighestScores[0]
+		summary += fmt.Sprintf(", highest score %.2f by %s in %s week %d", top.Value, top.DisplayName, top.Season, top.Week)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    records,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: history.APICalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildLeagueRecords computes the record book from every season's games. Streaks,
+// season points and season records only count regular season games.
+func buildLeagueRecords(history *leagueGameHistory, limit int, includePlayoffs bool) *LeagueRecords {
+	names := history.ownerNames()
+	records := &LeagueRecords{
+		LeagueName: history.Name,
+		Warnings:   history.Warnings,
+	}
+
+	var singleGames, margins []RecordEntry
+	var seasonPoints, seasonRecords []RecordEntry
+	var winStreaks, lossStreaks []RecordEntry
+
+	type streak struct {
+		result      string
+		length      int
+		startSeason string
+		startWeek   int
+		lastSeason  string
+		lastWeek    int
+	}
+	streaks := make(map[string]*streak)
+	closeStreak := func(ownerID string, active bool) {
+		current := streaks[ownerID]
+		if current == nil || current.length == 0 {
+			return
+		}
+		entry := RecordEntry{
+			Value:       float64(current.length),
+			OwnerID:     ownerID,
+			DisplayName: names[ownerID],
+			Season:      current.startSeason,
+			Week:        current.startWeek,
+			EndSeason:   current.lastSeason,
+			EndWeek:     current.lastWeek,
+			Active:      active,
+		}
+		switch current.result {
+		case "W":
+			winStreaks = append(winStreaks, entry)
+		case "L":
+			lossStreaks = append(lossStreaks, entry)
+		}
+	}
+
+	for _, gameLog := range history.Seasons {
+		records.Seasons = append(records.Seasons, gameLog.Season)
+
+		type seasonTotals struct {
+			wins, losses, ties int
+			pointsFor          float64
+		}
+		totals := make(map[string]*seasonTotals)
+
+		for _, game := range gameLog.Games {
+			if game.OwnerID == "" {
+				continue
+			}
+			if game.RosterID < game.OpponentRosterID {
+				records.GamesScanned++ // Each game appears once per team
+			}
+
+			if includePlayoffs || game.GameType == GameTypeRegular {
+				entry := RecordEntry{
+					Value:       game.PointsFor,
+					OwnerID:     game.OwnerID,
+					DisplayName: names[game.OwnerID],
+					Season:      game.Season,
+					Week:        game.Week,
+					Opponent:    names[game.OpponentOwnerID],
+					Score:       fmt.Sprintf("%.2f-%.2f", game.PointsFor, game.PointsAgainst),
+					GameType:    game.GameType,
+				}
+				singleGames = append(singleGames, entry)
+
+				if game.Result == "W" {
+					entry.Value = math.Round((game.PointsFor-game.PointsAgainst)*100) / 100
+					margins = append(margins, entry)
+				}
+			}
+
+			if game.GameType != GameTypeRegular {
+				continue
+			}
+
+			if totals[game.OwnerID] == nil {
+				totals[game.OwnerID] = &seasonTotals{}
+			}
+			seasonTotal := totals[game.OwnerID]
+			seasonTotal.pointsFor += game.PointsFor
+			switch game.Result {
+			case "W":
+				seasonTotal.wins++
+			case "L":
+				seasonTotal.losses++
+			case "T":
+				seasonTotal.ties++
+			}
+
+			// Streaks run across seasons; a tie ends any streak
+			current := streaks[game.OwnerID]
+			if current == nil || current.result != game.Result {
+				closeStreak(game.OwnerID, false)
+				current = &streak{result: game.Result, startSeason: game.Season, startWeek: game.Week}
+				streaks[game.OwnerID] = current
+			}
+			current.length++
+			current.lastSeason, current.lastWeek = game.Season, game.Week
+		}
+
+		for ownerID, seasonTotal := range totals {
+			games := seasonTotal.wins + seasonTotal.losses + seasonTotal.ties
+			if games == 0 {
+				continue
+			}
+			record := fmt.Sprintf("%d-%d-%d", seasonTotal.wins, seasonTotal.losses, seasonTotal.ties)
+			seasonPoints = append(seasonPoints, RecordEntry{
+				Value:       math.Round(seasonTotal.pointsFor*100) / 100,
+				OwnerID:     ownerID,
+				DisplayName: names[ownerID],
+				Season:      gameLog.Season,
+				Record:      record,
+			})
+			seasonRecords = append(seasonRecords, RecordEntry{
+				Value:       math.Round((float64(seasonTotal.wins)+float64(seasonTotal.ties)*0.5)/float64(games)*1000) / 1000,
+				OwnerID:     ownerID,
+				DisplayName: names[ownerID],
+				Season:      gameLog.Season,
+				Record:      record,
+			})
+		}
+	}
+
+	// Only owners still playing in the latest season have a running streak
+	latestSeason := ""
+	if len(history.Seasons) > 0 {
+		latestSeason = history.Seasons[len(history.Seasons)-1].Season
+	}
+	for ownerID, current := range streaks {
+		closeStreak(ownerID, current.lastSeason == latestSeason)
+	}
+
+	records.HighestScores = topRecords(singleGames, limit, true)
+	records.LowestScores = topRecords(singleGames, limit, false)
+	records.LargestMargins = topRecords(margins, limit, true)
+	records.LongestWinStreaks = topRecords(winStreaks, limit, true)
+	records.LongestLossStreaks = topRecords(lossStreaks, limit, true)
+	records.MostSeasonPoints = topRecords(seasonPoints, limit, true)
+	records.BestRecords = topRecords(seasonRecords, limit, true)
+
+	leagues := make([]*sleeper.League, 0, len(history.Seasons))
+	for _, gameLog := range history.Seasons {
+		leagues = append(leagues, gameLog.League)
+	}
+	for _, diff := range diffSeasonSettings(leagues) {
+		for _, change := range diff.Changes {
+			if change.Category == SettingsCategoryLeague {
+				continue
+			}
+			records.RuleChanges = append(records.RuleChanges, fmt.Sprintf("%s: %s", diff.ToSeason, change.Description))
+		}
+	}
+
+	return records
+}
+
+// topRecords sorts record entries by value and returns the first limit entries.
+// Ties are broken chronologically so the earliest holder ranks first.
+func topRecords(entries []RecordEntry, limit int, descending bool) []RecordEntry {
+	sorted := make([]RecordEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Value != sorted[j].Value {
+			if descending {
+				return sorted[i].Value > sorted[j].Value
+			}
+			return sorted[i].Value < sorted[j].Value
+		}
+		if sorted[i].Season != sorted[j].Season {
+			return sorted[i].Season < sorted[j].Season
+		}
+		if sorted[i].Week != sorted[j].Week {
+			return sorted[i].Week < sorted[j].Week
+		}
+		return sorted[i].OwnerID < sorted[j].OwnerID
+	})
+
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	return sorted
+}
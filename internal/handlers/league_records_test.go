@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// newHistoryMockClient builds a two-season league where the owners swap roster IDs
+// between seasons, with a winners bracket game in 2023 week 3
+func newHistoryMockClient() *MockSleeperClient {
+	users := []sleeper.User{
+		{UserID: "user1", DisplayName: "Player One"},
+		{UserID: "user2", DisplayName: "Player Two"},
+	}
+	leagues := map[string]*sleeper.League{
+		"league2024": {LeagueID: "league2024", Name: "Test League", Season: "2024", Status: "in_season", PreviousLeagueID: "league2023",
+			Settings: sleeper.LeagueSettings{PlayoffWeekStart: 3, LastScoredLeg: 2}},
+		"league2023": {LeagueID: "league2023", Name: "Test League", Season: "2023", Status: "complete",
+			Settings: sleeper.LeagueSettings{PlayoffWeekStart: 3, LastScoredLeg: 3}},
+	}
+	rosters := map[string][]sleeper.Roster{
+		"league2023": {{RosterID: 1, OwnerID: "user1"}, {RosterID: 2, OwnerID: "user2"}},
+		"league2024": {{RosterID: 1, OwnerID: "user2"}, {RosterID: 2, OwnerID: "user1"}},
+	}
+	game := func(team1, team2 float64) []sleeper.Matchup {
+		return []sleeper.Matchup{
+			{RosterID: 1, MatchupID: 1, Points: team1},
+			{RosterID: 2, MatchupID: 1, Points: team2},
+		}
+	}
+	matchups := map[string]map[int][]sleeper.Matchup{
+		"league2023": {1: game(150, 100), 2: game(90, 95), 3: game(120, 80)},
+		"league2024": {1: game(130, 60), 2: game(110, 100)},
+	}
+
+	return &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return leagues[leagueID], nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return users, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return rosters[leagueID], nil
+		},
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			return matchups[leagueID][week], nil
+		},
+		GetWinnersBracketFunc: func(leagueID string) ([]sleeper.BracketMatchup, error) {
+			if leagueID == "league2023" {
+				return []sleeper.BracketMatchup{{MatchupID: 1, Round: 1, Team1: 1, Team2: 2, Winner: 1, Loser: 2}}, nil
+			}
+			return []sleeper.BracketMatchup{}, nil
+		},
+	}
+}
+
+func TestLeagueHandler_LoadLeagueGameHistory(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(newHistoryMockClient(), logger)
+
+	history, err := handler.loadLeagueGameHistory("league2024")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(history.Seasons) != 2 || history.Seasons[0].Season != "2023" {
+		t.Fatalf("Expected seasons 2023 and 2024 oldest first, got %d seasons", len(history.Seasons))
+	}
+
+	games2023 := history.Seasons[0].Games
+	if len(games2023) != 6 {
+		t.Fatalf("Expected 6 team-games in 2023, got %d", len(games2023))
+	}
+	if games2023[4].GameType != GameTypePlayoff || games2023[0].GameType != GameTypeRegular {
+		t.Errorf("Expected week 3 to be a playoff game, got %s", games2023[4].GameType)
+	}
+
+	// Roster 1 belongs to user2 in 2024
+	first2024 := history.Seasons[1].Games[0]
+	if first2024.OwnerID != "user2" || first2024.OpponentOwnerID != "user1" {
+		t.Errorf("Expected roster 1 to map to user2 in 2024, got %s vs %s", first2024.OwnerID, first2024.OpponentOwnerID)
+	}
+}
+
+func TestLeagueHandler_GetLeagueRecords(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(newHistoryMockClient(), logger)
+
+	result, err := handler.HandleGetLeagueRecords(context.Background(), map[string]interface{}{
+		"league_id": "league2024",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result")
+	}
+
+	var response struct {
+		Data LeagueRecords `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	records := response.Data
+
+	if records.GamesScanned != 5 {
+		t.Errorf("Expected 5 games scanned, got %d", records.GamesScanned)
+	}
+
+	highest := records.HighestScores[0]
+	if highest.Value != 150 || highest.OwnerID != "user1" || highest.Season != "2023" || highest.Week != 1 {
+		t.Errorf("Expected user1's 150 in 2023 week 1 as highest score, got %+v", highest)
+	}
+	if lowest := records.LowestScores[0]; lowest.Value != 60 || lowest.Season != "2024" {
+		t.Errorf("Expected 60 in 2024 as lowest score, got %+v", lowest)
+	}
+	if margin := records.LargestMargins[0]; margin.Value != 70 || margin.OwnerID != "user2" {
+		t.Errorf("Expected user2's 70 point win as largest margin, got %+v", margin)
+	}
+
+	// Streaks span seasons and ignore the playoff game
+	win := records.LongestWinStreaks[0]
+	if win.OwnerID != "user2" || win.Value != 3 || !win.Active || win.Season != "2023" || win.EndSeason != "2024" {
+		t.Errorf("Expected user2's active 3 game win streak from 2023, got %+v", win)
+	}
+	loss := records.LongestLossStreaks[0]
+	if loss.OwnerID != "user1" || loss.Value != 3 {
+		t.Errorf("Expected user1's 3 game loss streak, got %+v", loss)
+	}
+
+	// 240 points ties between user1 in 2023 and user2 in 2024; the earlier season ranks first
+	if points := records.MostSeasonPoints[0]; points.Value != 240 || points.Season != "2023" || points.OwnerID != "user1" {
+		t.Errorf("Expected user1's 240 points in 2023 first, got %+v", points)
+	}
+	if best := records.BestRecords[0]; best.OwnerID != "user2" || best.Season != "2024" || best.Record != "2-0-0" {
+		t.Errorf("Expected user2's 2-0-0 2024 as best record, got %+v", best)
+	}
+}
+
+func TestBuildLeagueRecords_ActiveStreaks(t *testing.T) {
+	game := func(season string, week int, ownerID, opponentID, result string) SeasonGame {
+		return SeasonGame{Season: season, Week: week, GameType: GameTypeRegular, OwnerID: ownerID, OpponentOwnerID: opponentID, Result: result}
+	}
+	// user3 leaves the league after 2023 on a two game win streak
+	history := &leagueGameHistory{
+		Owners: &ownerRegistry{},
+		Seasons: []*seasonGameLog{
+			{Season: "2023", Games: []SeasonGame{
+				game("2023", 1, "user3", "user1", "W"), game("2023", 1, "user1", "user3", "L"),
+				game("2023", 2, "user3", "user1", "W"), game("2023", 2, "user1", "user3", "L"),
+			}},
+			{Season: "2024", Games: []SeasonGame{
+				game("2024", 1, "user2", "user1", "W"), game("2024", 1, "user1", "user2", "L"),
+			}},
+		},
+	}
+
+	records := buildLeagueRecords(history, 10, false)
+
+	active := make(map[string]bool)
+	for _, streak := range append(records.LongestWinStreaks, records.LongestLossStreaks...) {
+		active[streak.OwnerID] = streak.Active
+	}
+	if active["user3"] {
+		t.Error("Expected user3's streak to have ended when they left the league")
+	}
+	if !active["user1"] || !active["user2"] {
+		t.Errorf("Expected user1 and user2 to have active streaks, got %v", active)
+	}
+}
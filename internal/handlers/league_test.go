@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
 	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
 	"github.com/sirupsen/logrus/hooks/test"
 )
@@ -23,6 +24,9 @@ type MockSleeperClient struct {
 	GetLosersBracketFunc    func(leagueID string) ([]sleeper.BracketMatchup, error)
 	GetAllPlayersFunc       func() (map[string]sleeper.Player, error)
 	GetTrendingPlayersFunc  func(sport, trendType string, hours, limit int) ([]sleeper.TrendingPlayer, error)
+	GetNFLStateFunc         func() (*sleeper.NFLState, error)
+	GetWeeklyStatsFunc      func(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error)
+	GetProjectionsFunc      func(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error)
 }
 
 func (m *MockSleeperClient) GetUser(usernameOrID string) (*sleeper.User, error) {
@@ -102,6 +106,27 @@ func (m *MockSleeperClient) GetLosersBracket(leagueID string) ([]sleeper.Bracket
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockSleeperClient) GetNFLState() (*sleeper.NFLState, error) {
+	if m.GetNFLStateFunc != nil {
+		return m.GetNFLStateFunc()
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockSleeperClient) GetWeeklyStats(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error) {
+	if m.GetWeeklyStatsFunc != nil {
+		return m.GetWeeklyStatsFunc(seasonType, season, week)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockSleeperClient) GetProjections(seasonType, season string, week int) (map[string]sleeper.PlayerStats, error) {
+	if m.GetProjectionsFunc != nil {
+		return m.GetProjectionsFunc(seasonType, season, week)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func TestLeagueHandler_GetLeagueInfoTool(t *testing.T) {
 	logger, _ := test.NewNullLogger()
 	mockClient := &MockSleeperClient{}
@@ -702,4 +727,304 @@ func TestNewLeagueHandler(t *testing.T) {
 	if handler.logger != logger {
 		t.Error("Expected handler to use provided logger")
 	}
-}
\ No newline at end of file
+}
+
+func TestLeagueHandler_ApplyRandomTiebreaker_Reproducible(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(&MockSleeperClient{}, logger)
+	handler.config = &config.LeagueConfig{Leagues: make(map[string]config.LeagueSettings)}
+	
+	league := &sleeper.League{LeagueID: "test123", Season: "2024"}
+	seed := handler.randomTiebreakerSeed("test123", league)
+	if seed != "test123:2024" {
+		t.Errorf("Expected derived seed 'test123:2024', got '%s'", seed)
+	}
+	
+	newStandings := func() []StandingEntry {
+		return []StandingEntry{
+			{RosterID: 1, Wins: 8, PointsFor: 1400},
+			{RosterID: 2, Wins: 8, PointsFor: 1400},
+			{RosterID: 3, Wins: 8, PointsFor: 1400},
+		}
+	}
+	order := []string{"wins", "points_for", "random"}
+	
+	first := newStandings()
+	handler.applyRandomTiebreaker(first, order, seed)
+	first = sortStandingsWithTiebreakers(first, order, nil, nil)
+	
+	second := newStandings()
+	handler.applyRandomTiebreaker(second, order, seed)
+	second = sortStandingsWithTiebreakers(second, order, nil, nil)
+	
+	for i := range first {
+		if first[i].RosterID != second[i].RosterID {
+			t.Fatalf("Expected identical order for identical inputs, got %v and %v", first, second)
+		}
+		if first[i].RandomTiebreakerSeed != seed {
+			t.Errorf("Expected seed %q recorded on entry, got %q", seed, first[i].RandomTiebreakerSeed)
+		}
+		if first[i].RandomTiebreakerID != generateRandomTiebreakerID(seed, first[i].RosterID) {
+			t.Errorf("Expected tiebreaker ID to be derived from seed for roster %d", first[i].RosterID)
+		}
+	}
+	
+	// A configured seed takes precedence over the derived one
+	handler.config.Leagues["test123"] = config.LeagueSettings{
+		Custom: config.CustomStandings{Enabled: true, RandomSeed: "published-2024"},
+	}
+	if got := handler.randomTiebreakerSeed("test123", league); got != "published-2024" {
+		t.Errorf("Expected configured seed 'published-2024', got '%s'", got)
+	}
+	
+	// A default seed would give every league the same coin flips, so it is ignored
+	handler.config.DefaultSettings = config.LeagueSettings{
+		Custom: config.CustomStandings{RandomSeed: "shared-seed"},
+	}
+	other := &sleeper.League{LeagueID: "other456", Season: "2024"}
+	if got := handler.randomTiebreakerSeed("other456", other); got != "other456:2024" {
+		t.Errorf("Expected derived seed 'other456:2024' despite a default seed, got '%s'", got)
+	}
+}
+
+func TestBuildWeeklyMatchups(t *testing.T) {
+	matchups := []sleeper.Matchup{
+		{
+			RosterID:       1,
+			MatchupID:      1,
+			Points:         101.5,
+			Starters:       []string{"p1", "KC"},
+			StartersPoints: []float64{91.5, 10},
+			Players:        []string{"p1", "KC", "p3", "p4"},
+			PlayersPoints:  map[string]float64{"p1": 91.5, "KC": 10, "p3": 22.4, "p4": 30},
+		},
+		{RosterID: 2, MatchupID: 1, Points: 120.25},
+		{RosterID: 3, MatchupID: 0, Points: 80},
+	}
+	
+	mc := &matchupContext{
+		league: &sleeper.League{RosterPositions: []string{"QB", "DEF", "BN"}},
+		rosters: map[int]sleeper.Roster{
+			1: {RosterID: 1, OwnerID: "user1", Reserve: []string{"p4"}},
+			2: {RosterID: 2, OwnerID: "user2"},
+		},
+		users: map[string]sleeper.User{
+			"user1": {UserID: "user1", DisplayName: "Player One", Metadata: sleeper.UserMetadata{TeamName: "Team One"}},
+			"user2": {UserID: "user2", DisplayName: "Player Two"},
+		},
+		players: map[string]sleeper.Player{
+			"p1": {PlayerID: "p1", FullName: "Quarter Back", Position: "QB", Team: "BUF"},
+			"KC": {PlayerID: "KC", FirstName: "Kansas City", LastName: "Chiefs", Position: "DEF", Team: "KC"},
+		},
+	}
+	
+	weekly := buildWeeklyMatchups(3, matchups, mc)
+	
+	if len(weekly.Matchups) != 1 {
+		t.Fatalf("Expected 1 paired matchup, got %d", len(weekly.Matchups))
+	}
+	if len(weekly.Unpaired) != 1 || weekly.Unpaired[0].RosterID != 3 {
+		t.Errorf("Expected roster 3 to be unpaired, got %+v", weekly.Unpaired)
+	}
+	
+	pairing := weekly.Matchups[0]
+	if pairing.WinnerRosterID != 2 || pairing.Teams[0].RosterID != 2 {
+		t.Errorf("Expected roster 2 to win and be listed first, got winner %d", pairing.WinnerRosterID)
+	}
+	if pairing.Margin != 18.75 {
+		t.Errorf("Expected margin 18.75, got %.2f", pairing.Margin)
+	}
+	
+	loser := pairing.Teams[1]
+	if loser.DisplayName != "Player One" || loser.TeamName != "Team One" {
+		t.Errorf("Expected owner names to be joined, got %q / %q", loser.DisplayName, loser.TeamName)
+	}
+	if loser.Starters[1].Name != "Kansas City Chiefs" || loser.Starters[1].Slot != "DEF" {
+		t.Errorf("Expected defense starter with DEF slot, got %+v", loser.Starters[1])
+	}
+	if len(loser.Bench) != 1 || loser.BenchPoints != 22.4 {
+		t.Errorf("Expected only p3 on the bench with 22.4 points (p4 is on IR), got %d players and %.2f", len(loser.Bench), loser.BenchPoints)
+	}
+	
+	if weekly.HighScore.RosterID != 2 || weekly.LowScore.RosterID != 1 {
+		t.Errorf("Expected high score roster 2 and low score roster 1, got %d and %d", weekly.HighScore.RosterID, weekly.LowScore.RosterID)
+	}
+}
+
+func TestBuildLiveScoreboard(t *testing.T) {
+	matchups := []sleeper.Matchup{
+		{
+			RosterID:       1,
+			MatchupID:      1,
+			Points:         20,
+			Starters:       []string{"p1", "p2", "p6"},
+			StartersPoints: []float64{20, 0, 0},
+		},
+		{
+			RosterID:       2,
+			MatchupID:      1,
+			Points:         12,
+			Starters:       []string{"p3", "p4", "p5"},
+			StartersPoints: []float64{12, 0, 0},
+		},
+	}
+	
+	mc := &matchupContext{
+		league: &sleeper.League{
+			RosterPositions: []string{"QB", "WR", "FLEX"},
+			ScoringSettings: map[string]float64{"pass_yd": 0.04, "rec": 1, "rec_yd": 0.1},
+		},
+		rosters: map[int]sleeper.Roster{},
+		users:   map[string]sleeper.User{},
+		players: map[string]sleeper.Player{
+			"p1": {PlayerID: "p1", Team: "KC"},
+			"p2": {PlayerID: "p2", Team: "BUF"},
+			"p3": {PlayerID: "p3", Team: "SF"},
+			"p4": {PlayerID: "p4", Team: "SF"},
+			"p5": {PlayerID: "p5", Team: "KC"}, // Held scoreless, but KC's game has started
+			"p6": {PlayerID: "p6", Team: "DET"}, // On bye in week 5 of 2024
+		},
+	}
+	
+	state := &sleeper.NFLState{Week: 5, Season: "2024", SeasonType: "regular"}
+	stats := map[string]sleeper.PlayerStats{
+		"p1": {"pass_yd": 500},
+		"p4": {"rec": 0},
+	}
+	projections := map[string]sleeper.PlayerStats{
+		"p1": {"pass_yd": 750},
+		"p2": {"rec": 5, "rec_yd": 60, "pts_ppr": 11},
+		"p5": {"rec": 2},
+		"p4": {"rec": 8, "rec_yd": 100},
+	}
+	
+	scoreboard := buildLiveScoreboard(state, "2024", 5, matchups, mc, stats, projections, bundledScheduleLoader(t).season("2024"))
+	
+	if scoreboard.WeekStatus != WeekStatusInProgress || !scoreboard.InProgress {
+		t.Errorf("Expected week to be in progress, got %s", scoreboard.WeekStatus)
+	}
+	if len(scoreboard.Matchups) != 1 {
+		t.Fatalf("Expected 1 matchup, got %d", len(scoreboard.Matchups))
+	}
+	
+	matchup := scoreboard.Matchups[0]
+	leader, trailer := matchup.Teams[0], matchup.Teams[1]
+	if matchup.LeaderRosterID != 1 || leader.RosterID != 1 {
+		t.Errorf("Expected roster 1 to lead, got %d", matchup.LeaderRosterID)
+	}
+	if leader.PlayersInProgress != 1 || leader.PlayersYetToPlay != 1 || leader.PlayersPlayed != 0 {
+		t.Errorf("Expected 1 in progress and 1 yet to play for roster 1, got %d and %d", leader.PlayersInProgress, leader.PlayersYetToPlay)
+	}
+	if len(leader.OnBye) != 1 || leader.OnBye[0].PlayerID != "p6" || leader.OnBye[0].Status != LiveStatusBye {
+		t.Errorf("Expected p6 to be on bye for roster 1, got %+v", leader.OnBye)
+	}
+	if trailer.PlayersYetToPlay != 1 || trailer.YetToPlay[0].PlayerID != "p4" {
+		t.Errorf("Expected p4 to be yet to play for roster 2, got %+v", trailer.YetToPlay)
+	}
+	if trailer.PlayersInProgress != 2 || trailer.InProgress[1].PlayerID != "p5" {
+		t.Errorf("Expected scoreless p5 to count as in progress for roster 2, got %+v", trailer.InProgress)
+	}
+	
+	// p1 is mid-game with 20 of a projected 30, plus (5 rec + 6 rec_yd) = 41;
+	// 12 actual + scoreless p5's projected 2 + (8 + 10) projected = 32
+	if leader.ProjectedFinal == nil || *leader.ProjectedFinal != 41 {
+		t.Errorf("Expected roster 1 projected final of 41, got %v", leader.ProjectedFinal)
+	}
+	if trailer.ProjectedFinal == nil || *trailer.ProjectedFinal != 32 {
+		t.Errorf("Expected roster 2 projected final of 32, got %v", trailer.ProjectedFinal)
+	}
+	if matchup.ProjectedWinnerID != 1 {
+		t.Errorf("Expected roster 1 to be the projected winner, got %d", matchup.ProjectedWinnerID)
+	}
+	
+	// Once the week is over, actual points are final
+	scoreboard = buildLiveScoreboard(&sleeper.NFLState{Week: 6, Season: "2024", SeasonType: "regular"}, "2024", 5, matchups, mc, stats, projections, nil)
+	if leader := scoreboard.Matchups[0].Teams[0]; leader.PlayersPlayed != 1 || *leader.Played[0].ProjectedPoints != 20 {
+		t.Errorf("Expected p1's 20 points to be final in a completed week, got %+v", leader.Played)
+	}
+	
+	if status := liveWeekStatus(state, "2024", 6, false); status != WeekStatusUpcoming {
+		t.Errorf("Expected week 6 to be upcoming, got %s", status)
+	}
+	if status := liveWeekStatus(state, "2023", 14, false); status != WeekStatusComplete {
+		t.Errorf("Expected a past season week to be complete, got %s", status)
+	}
+}
+
+func TestLeagueHandler_HandleGetLiveMatchups_Offseason(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	mockClient := &MockSleeperClient{
+		GetNFLStateFunc: func() (*sleeper.NFLState, error) {
+			return &sleeper.NFLState{Week: 0, Season: "2025", SeasonType: "off"}, nil
+		},
+	}
+	handler := NewLeagueHandler(mockClient, logger)
+
+	result, err := handler.HandleGetLiveMatchups(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result when no week is being played")
+	}
+}
+
+func TestLeagueHandler_CalculateFinalStandingsOrder(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(&MockSleeperClient{}, logger)
+
+	regularSeason := []StandingEntry{
+		{RosterID: 1}, {RosterID: 2}, {RosterID: 3}, {RosterID: 4},
+		{RosterID: 5}, {RosterID: 6}, {RosterID: 7}, {RosterID: 8},
+	}
+	bracket := &PlayoffBracket{
+		PlayoffTeams:  map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6},
+		QuarterFinals: []PlayoffMatchup{{Winner: 3, Loser: 6}, {Winner: 5, Loser: 4}},
+		Championship:  &PlayoffMatchup{Winner: 5, Loser: 2},
+		ThirdPlace:    &PlayoffMatchup{Winner: 1, Loser: 3},
+	}
+
+	final := handler.calculateFinalStandings(regularSeason, bracket)
+
+	// Playoff outcomes first, then regular season rank within the same outcome
+	expected := []int{5, 2, 1, 3, 4, 6, 7, 8}
+	for i, rosterID := range expected {
+		if final[i].RosterID != rosterID || final[i].Rank != i+1 {
+			t.Errorf("Expected roster %d at rank %d, got roster %d (%s)", rosterID, i+1, final[i].RosterID, final[i].PlayoffOutcome)
+		}
+	}
+}
+
+func TestLeagueHandler_ProcessBracketFromAPI_PlacementGames(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(&MockSleeperClient{}, logger)
+
+	place := func(p int) *int { return &p }
+	// 6-team bracket where the quarterfinal losers play for 5th place during the semifinals
+	winnersBracket := []sleeper.BracketMatchup{
+		{MatchupID: 1, Round: 1, Team1: 3, Team2: 6, Winner: 3, Loser: 6},
+		{MatchupID: 2, Round: 1, Team1: 4, Team2: 5, Winner: 5, Loser: 4},
+		{MatchupID: 3, Round: 2, Team1: 1, Team2: 5, Winner: 5, Loser: 1},
+		{MatchupID: 4, Round: 2, Team1: 2, Team2: 3, Winner: 2, Loser: 3},
+		{MatchupID: 5, Round: 2, Team1: 6, Team2: 4, Winner: 4, Loser: 6, PlayoffWeek: place(5)},
+		{MatchupID: 6, Round: 3, Team1: 5, Team2: 2, Winner: 5, Loser: 2, PlayoffWeek: place(1)},
+		{MatchupID: 7, Round: 3, Team1: 1, Team2: 3, Winner: 1, Loser: 3, PlayoffWeek: place(3)},
+	}
+
+	bracket := &PlayoffBracket{}
+	if err := handler.processBracketFromAPI(bracket, winnersBracket); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(bracket.QuarterFinals) != 2 {
+		t.Errorf("Expected 2 quarterfinals, got %d", len(bracket.QuarterFinals))
+	}
+	if len(bracket.SemiFinals) != 2 {
+		t.Errorf("Expected the 5th place game to be left out of the semifinals, got %d semifinals", len(bracket.SemiFinals))
+	}
+	if bracket.Championship.Winner != 5 || bracket.ThirdPlace.Winner != 1 {
+		t.Errorf("Expected roster 5 champion and roster 1 third, got %d and %d", bracket.Championship.Winner, bracket.ThirdPlace.Winner)
+	}
+}
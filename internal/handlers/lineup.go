@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"math"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// slotEligibility maps flex lineup slots to the positions that can fill them.
+// Any other slot is filled only by its own position.
+var slotEligibility = map[string][]string{
+	"FLEX":       {"RB", "WR", "TE"},
+	"WRRB_FLEX":  {"RB", "WR"},
+	"REC_FLEX":   {"WR", "TE"},
+	"SUPER_FLEX": {"QB", "RB", "WR", "TE"},
+	"IDP_FLEX":   {"DL", "LB", "DB"},
+}
+
+// depthChartPositions maps NFL depth chart positions to the fantasy positions Sleeper
+// uses for lineup slots, for players without fantasy positions
+var depthChartPositions = map[string]string{
+	"FB":  "RB",
+	"DE":  "DL",
+	"DT":  "DL",
+	"NT":  "DL",
+	"ILB": "LB",
+	"OLB": "LB",
+	"MLB": "LB",
+	"CB":  "DB",
+	"S":   "DB",
+	"SS":  "DB",
+	"FS":  "DB",
+}
+
+// eligiblePositions returns the positions that can fill a lineup slot
+func eligiblePositions(slot string) []string {
+	if positions, exists := slotEligibility[slot]; exists {
+		return positions
+	}
+	return []string{slot}
+}
+
+// playerPositions returns every fantasy position a player is eligible at, preferring
+// Sleeper's fantasy positions and falling back to the player's depth chart position
+func playerPositions(player sleeper.Player) []string {
+	raw := player.FantasyPositions
+	if len(raw) == 0 && player.Position != "" {
+		raw = []string{player.Position}
+	}
+
+	var positions []string
+	seen := make(map[string]bool)
+	for _, position := range raw {
+		if fantasyPosition, exists := depthChartPositions[position]; exists {
+			position = fantasyPosition
+		}
+		if !seen[position] {
+			seen[position] = true
+			positions = append(positions, position)
+		}
+	}
+	return positions
+}
+
+// lineupCandidate is a player available to fill a lineup slot
+type lineupCandidate struct {
+	PlayerID  string
+	Positions []string
+	Points    float64
+}
+
+// canFill reports whether a candidate is eligible for a lineup slot
+func (c lineupCandidate) canFill(slot string) bool {
+	for _, eligible := range eligiblePositions(slot) {
+		for _, position := range c.Positions {
+			if position == eligible {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unfilledSlotPenalty outweighs any player's points, so the optimizer fills every slot
+// it can before it maximizes points
+const unfilledSlotPenalty = 1e6
+
+// optimalLineup picks the highest-scoring lineup for the given slots. Slots are assigned
+// together rather than one at a time, so a multi-position player lands wherever the
+// lineup gains the most from them.
+// It returns the lineup's points and the player ID chosen for each slot ("" if unfilled).
+func optimalLineup(slots []string, candidates []lineupCandidate) (float64, []string) {
+	chosen := make([]string, len(slots))
+	if len(slots) == 0 {
+		return 0, chosen
+	}
+
+	// Columns past the candidates stand for leaving a slot empty
+	columns := len(candidates)
+	if columns < len(slots) {
+		columns = len(slots)
+	}
+	assignment := minCostAssignment(len(slots), columns, func(slot, column int) float64 {
+		if column < len(candidates) && candidates[column].canFill(slots[slot]) {
+			return -(unfilledSlotPenalty + candidates[column].Points)
+		}
+		return 0
+	})
+
+	total := 0.0
+	for slot, column := range assignment {
+		if column < len(candidates) && candidates[column].canFill(slots[slot]) {
+			chosen[slot] = candidates[column].PlayerID
+			total += candidates[column].Points
+		}
+	}
+
+	return math.Round(total*100) / 100, chosen
+}
+
+// minCostAssignment solves the assignment problem for rows <= columns with the
+// Hungarian algorithm, returning the column assigned to each row
+func minCostAssignment(rows, columns int, cost func(row, column int) float64) []int {
+	// Potentials and matches are 1-indexed, with column 0 as the search root
+	u := make([]float64, rows+1)
+	v := make([]float64, columns+1)
+	match := make([]int, columns+1)
+	way := make([]int, columns+1)
+
+	for row := 1; row <= rows; row++ {
+		match[0] = row
+		current := 0
+		minSlack := make([]float64, columns+1)
+		for j := range minSlack {
+			minSlack[j] = math.Inf(1)
+		}
+		visited := make([]bool, columns+1)
+
+		for match[current] != 0 {
+			visited[current] = true
+			matchedRow := match[current]
+			delta := math.Inf(1)
+			next := 0
+			for j := 1; j <= columns; j++ {
+				if visited[j] {
+					continue
+				}
+				slack := cost(matchedRow-1, j-1) - u[matchedRow] - v[j]
+				if slack < minSlack[j] {
+					minSlack[j] = slack
+					way[j] = current
+				}
+				if minSlack[j] < delta {
+					delta = minSlack[j]
+					next = j
+				}
+			}
+			for j := 0; j <= columns; j++ {
+				if visited[j] {
+					u[match[j]] += delta
+					v[j] -= delta
+				} else {
+					minSlack[j] -= delta
+				}
+			}
+			current = next
+		}
+
+		// Flip the augmenting path back to the root
+		for current != 0 {
+			previous := way[current]
+			match[current] = match[previous]
+			current = previous
+		}
+	}
+
+	assignment := make([]int, rows)
+	for j := 1; j <= columns; j++ {
+		if match[j] != 0 {
+			assignment[match[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
+
+// lineupCandidates builds the optimizer's candidates from a team's matchup row,
+// leaving out IR and taxi players
+func (mc *matchupContext) lineupCandidates(matchup sleeper.Matchup) []lineupCandidate {
+	var candidates []lineupCandidate
+	stashed := mc.stashedPlayers(matchup.RosterID)
+	for _, playerID := range matchup.Players {
+		player, exists := mc.players[playerID]
+		if !exists || stashed[playerID] {
+			continue
+		}
+
+		candidates = append(candidates, lineupCandidate{
+			PlayerID:  playerID,
+			Positions: playerPositions(player),
+			Points:    matchup.PlayersPoints[playerID],
+		})
+	}
+	return candidates
+}
@@ -0,0 +1,369 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/nfl"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Lineup problems flagged by check_lineups
+const (
+	LineupProblemEmpty    = "empty"
+	LineupProblemInjured  = "injured"
+	LineupProblemBye      = "bye"
+	LineupProblemInactive = "inactive"
+)
+
+// unavailableInjuryStatuses are injury designations that make a starter unlikely to play
+var unavailableInjuryStatuses = map[string]bool{
+	"Out":      true,
+	"Doubtful": true,
+	"IR":       true,
+	"PUP":      true,
+	"Sus":      true,
+	"COV":      true,
+}
+
+// LineupPlayer represents a player named in a lineup check
+type LineupPlayer struct {
+	PlayerID     string `json:"player_id"`
+	Name         string `json:"name"`
+	Position     string `json:"position"`
+	Team         string `json:"team,omitempty"`
+	InjuryStatus string `json:"injury_status,omitempty"`
+}
+
+// LineupIssue represents a starting slot that won't score as set
+type LineupIssue struct {
+	Slot        string        `json:"slot"`
+	Starter     *LineupPlayer `json:"starter,omitempty"` // Nil for an empty slot
+	Problems    []string      `json:"problems"`
+	Detail      string        `json:"detail"`
+	Replacement *LineupPlayer `json:"replacement,omitempty"` // Healthy bench player who can fill the slot
+}
+
+// RosterLineupCheck represents one roster's lineup check
+type RosterLineupCheck struct {
+	RosterID  int           `json:"roster_id"`
+	OwnerName string        `json:"owner_name,omitempty"`
+	Compliant bool          `json:"compliant"`
+	Issues    []LineupIssue `json:"issues,omitempty"`
+}
+
+// LineupCompliance represents a league-wide lineup check for one week
+type LineupCompliance struct {
+	LeagueID        string              `json:"league_id"`
+	Season          string              `json:"season"`
+	Week            int                 `json:"week"`
+	ByesChecked     bool                `json:"byes_checked"` // False when no NFL schedule is loaded for the season
+	TeamsChecked    int                 `json:"teams_checked"`
+	TeamsWithIssues int                 `json:"teams_with_issues"`
+	Rosters         []RosterLineupCheck `json:"rosters"` // Teams with the most issues first
+}
+
+// CheckLineupsTool returns the MCP tool definition for check_lineups
+func (h *RosterHandler) CheckLineupsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "check_lineups",
+		Description: "Check every team's starting lineup for the upcoming week and flag empty slots, starters who are Out, Doubtful or on IR, starters on bye and inactive or retired players, each with a suggested healthy bench replacement. Gives commissioners a league-wide view for nudging absent owners.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"roster_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "Check only this team (default: every team)",
+					"required":    false,
+				},
+				"week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Week used for bye checks (default: the first unscored week)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleCheckLineups handles the check_lineups tool call
+func (h *RosterHandler) HandleCheckLineups(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling check_lineups")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+	rosterID := 0
+	if rosterIDFloat, ok := args["roster_id"].(float64); ok {
+		rosterID = int(rosterIDFloat)
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	week, _ := remainingWeeks(league)
+	if weekFloat, ok := args["week"].(float64); ok {
+		week = int(weekFloat)
+		if week < 1 || week > 18 {
+			return nil, fmt.Errorf("week must be between 1 and 18")
+		}
+	} else if week > 18 {
+		return toolErrorResult(fmt.Sprintf("Every week of the %s season has been scored; pass a week to check", league.Season)), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+	if rosterID != 0 {
+		var selected []sleeper.Roster
+		for _, roster := range rosters {
+			if roster.RosterID == rosterID {
+				selected = append(selected, roster)
+			}
+		}
+		if len(selected) == 0 {
+			return toolErrorResult(fmt.Sprintf("Roster %d not found in league", rosterID)), nil
+		}
+		rosters = selected
+	}
+
+	allPlayers, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without owner names")
+	}
+
+	schedule := h.schedule.season(league.Season)
+	if schedule == nil {
+		h.logger.WithField("season", league.Season).Warn("No NFL schedule loaded, skipping bye checks")
+	}
+
+	compliance := checkLineups(rosters, users, allPlayers, league.RosterPositions, schedule, week)
+	compliance.LeagueID = leagueID
+	compliance.Season = league.Season
+
+	summary := fmt.Sprintf("All %d lineups are set for week %d", compliance.TeamsChecked, week)
+	if compliance.TeamsWithIssues > 0 {
+		var flagged []string
+		for _, check := range compliance.Rosters {
+			if check.Compliant {
+				break
+			}
+			name := check.OwnerName
+			if name == "" {
+				name = fmt.Sprintf("Team %d", check.RosterID)
+			}
+			flagged = append(flagged, fmt.Sprintf("%s (%d)", name, len(check.Issues)))
+		}
+		summary = fmt.Sprintf("%d of %d teams have lineup issues for week %d: %s",
+			compliance.TeamsWithIssues, compliance.TeamsChecked, week, strings.Join(flagged, ", "))
+	}
+	if !compliance.ByesChecked {
+		summary += "; " + noScheduleNote(league.Season)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    compliance,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: 4,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// checkLineups checks each roster's starters against its lineup slots for a week
+func checkLineups(rosters []sleeper.Roster, users []sleeper.User, allPlayers map[string]sleeper.Player, rosterPositions []string, schedule *nfl.SeasonSchedule, week int) *LineupCompliance {
+	ownerNames := make(map[string]string)
+	for _, user := range users {
+		ownerNames[user.UserID] = userDisplayName(user)
+	}
+
+	compliance := &LineupCompliance{
+		Week:         week,
+		ByesChecked:  schedule != nil,
+		TeamsChecked: len(rosters),
+		Rosters:      []RosterLineupCheck{},
+	}
+	slots := startingRosterSlots(rosterPositions)
+
+	for i := range rosters {
+		issues := checkRosterLineup(&rosters[i], allPlayers, slots, schedule, week)
+		compliance.Rosters = append(compliance.Rosters, RosterLineupCheck{
+			RosterID:  rosters[i].RosterID,
+			OwnerName: ownerNames[rosters[i].OwnerID],
+			Compliant: len(issues) == 0,
+			Issues:    issues,
+		})
+		if len(issues) > 0 {
+			compliance.TeamsWithIssues++
+		}
+	}
+
+	sort.SliceStable(compliance.Rosters, func(i, j int) bool {
+		return len(compliance.Rosters[i].Issues) > len(compliance.Rosters[j].Issues)
+	})
+	return compliance
+}
+
+// checkRosterLineup flags starting slots that won't score and suggests a bench replacement
+// for each. Replacements are healthy, eligible for the slot and not suggested twice.
+func checkRosterLineup(roster *sleeper.Roster, allPlayers map[string]sleeper.Player, slots []string, schedule *nfl.SeasonSchedule, week int) []LineupIssue {
+	unavailable := make(map[string]bool)
+	for _, playerID := range roster.Starters {
+		unavailable[playerID] = true
+	}
+	for _, playerID := range roster.Reserve {
+		unavailable[playerID] = true
+	}
+	for _, playerID := range roster.Taxi {
+		unavailable[playerID] = true
+	}
+
+	// Bench players who can play this week, most relevant first
+	var bench []lineupCandidate
+	for _, playerID := range roster.Players {
+		player, exists := allPlayers[playerID]
+		if !exists || unavailable[playerID] || len(lineupProblems(player, schedule, week)) > 0 {
+			continue
+		}
+		bench = append(bench, lineupCandidate{PlayerID: playerID, Positions: playerPositions(player)})
+	}
+	sort.SliceStable(bench, func(i, j int) bool {
+		return benchPreferred(allPlayers[bench[i].PlayerID], allPlayers[bench[j].PlayerID])
+	})
+
+	var issues []LineupIssue
+	for i, slot := range slots {
+		issue := LineupIssue{Slot: slot}
+
+		playerID := ""
+		if i < len(roster.Starters) {
+			playerID = roster.Starters[i]
+		}
+		player, exists := allPlayers[playerID]
+		switch {
+		case playerID == "" || playerID == "0":
+			issue.Problems = []string{LineupProblemEmpty}
+			issue.Detail = fmt.Sprintf("%s slot is empty", slot)
+		case !exists:
+			continue
+		default:
+			issue.Problems = lineupProblems(player, schedule, week)
+			if len(issue.Problems) == 0 {
+				continue
+			}
+			starter := newLineupPlayer(playerID, player)
+			issue.Starter = &starter
+			issue.Detail = lineupProblemDetail(player, issue.Problems, week)
+		}
+
+		for j, candidate := range bench {
+			if candidate.canFill(slot) {
+				replacement := newLineupPlayer(candidate.PlayerID, allPlayers[candidate.PlayerID])
+				issue.Replacement = &replacement
+				bench = append(bench[:j], bench[j+1:]...)
+				break
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// lineupProblems returns why a player won't score in a week, if anything
+func lineupProblems(player sleeper.Player, schedule *nfl.SeasonSchedule, week int) []string {
+	var problems []string
+	if (player.Status != "" && player.Status != "Active") || player.Team == "" {
+		problems = append(problems, LineupProblemInactive)
+	}
+	if unavailableInjuryStatuses[player.InjuryStatus] {
+		problems = append(problems, LineupProblemInjured)
+	}
+	if player.Team != "" && schedule.ByeWeek(player.Team) == week {
+		problems = append(problems, LineupProblemBye)
+	}
+	return problems
+}
+
+// lineupProblemDetail describes a starter's problems, e.g. "Davante Adams (WR, LV) is Out"
+func lineupProblemDetail(player sleeper.Player, problems []string, week int) string {
+	var reasons []string
+	for _, problem := range problems {
+		switch problem {
+		case LineupProblemInactive:
+			if player.Team == "" {
+				reasons = append(reasons, "not on an NFL team")
+			} else {
+				reasons = append(reasons, strings.ToLower(player.Status))
+			}
+		case LineupProblemInjured:
+			reasons = append(reasons, player.InjuryStatus)
+		case LineupProblemBye:
+			reasons = append(reasons, fmt.Sprintf("on bye in week %d", week))
+		}
+	}
+
+	team := player.Team
+	if team == "" {
+		team = "FA"
+	}
+	return fmt.Sprintf("%s (%s, %s) is %s", playerName(player), player.Position, team, strings.Join(reasons, " and "))
+}
+
+// unrankedSearchRank is the search rank Sleeper gives irrelevant players
+const unrankedSearchRank = 9999999
+
+// benchPreferred orders replacements: players without an injury designation first,
+// then by Sleeper's search rank
+func benchPreferred(a, b sleeper.Player) bool {
+	if aInjured, bInjured := a.InjuryStatus != "", b.InjuryStatus != ""; aInjured != bInjured {
+		return !aInjured
+	}
+	return benchSearchRank(a) < benchSearchRank(b)
+}
+
+// benchSearchRank returns a player's search rank, treating unranked players as irrelevant
+func benchSearchRank(player sleeper.Player) int {
+	if player.SearchRank <= 0 {
+		return unrankedSearchRank
+	}
+	return player.SearchRank
+}
+
+// newLineupPlayer builds a lineup check's view of a player
+func newLineupPlayer(playerID string, player sleeper.Player) LineupPlayer {
+	return LineupPlayer{
+		PlayerID:     playerID,
+		Name:         playerName(player),
+		Position:     player.Position,
+		Team:         player.Team,
+		InjuryStatus: player.InjuryStatus,
+	}
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestCheckRosterLineup(t *testing.T) {
+	players := map[string]sleeper.Player{
+		"qb1": {PlayerID: "qb1", FullName: "Jared Goff", Position: "QB", Team: "DET", Status: "Active"},
+		"qb2": {PlayerID: "qb2", FullName: "Sam Darnold", Position: "QB", Team: "MIN", Status: "Active"},
+		"rb1": {PlayerID: "rb1", FullName: "Nick Chubb", Position: "RB", Team: "CLE", Status: "Injured Reserve", InjuryStatus: "IR"},
+		"rb2": {PlayerID: "rb2", FullName: "Jaylen Warren", Position: "RB", Team: "PIT", Status: "Active", InjuryStatus: "Questionable", SearchRank: 90},
+		"rb3": {PlayerID: "rb3", FullName: "Tyjae Spears", Position: "RB", Team: "IND", Status: "Active", SearchRank: 150},
+		"wr1": {PlayerID: "wr1", FullName: "Davante Adams", Position: "WR", Team: "LV", Status: "Active", InjuryStatus: "Out"},
+		"wr2": {PlayerID: "wr2", FullName: "Mike Williams", Position: "WR", Team: "", Status: "Active"},
+		"wr3": {PlayerID: "wr3", FullName: "Courtland Sutton", Position: "WR", Team: "DEN", Status: "Active", InjuryStatus: "Doubtful"},
+		"te1": {PlayerID: "te1", FullName: "Sam LaPorta", Position: "TE", Team: "DET", Status: "Active"},
+	}
+	roster := &sleeper.Roster{
+		RosterID: 1,
+		Players:  []string{"qb1", "qb2", "rb1", "rb2", "rb3", "wr1", "wr2", "wr3", "te1"},
+		Starters: []string{"qb1", "rb1", "wr1", "0", "wr2"},
+		Reserve:  []string{},
+	}
+	slots := startingRosterSlots([]string{"QB", "RB", "WR", "WR", "FLEX", "BN", "BN", "BN", "BN", "IR"})
+
+	issues := checkRosterLineup(roster, players, slots, bundledScheduleLoader(t).season("2024"), 5)
+
+	if len(issues) != 5 {
+		t.Fatalf("Expected every starting slot to be flagged, got %+v", issues)
+	}
+
+	expected := []struct {
+		slot        string
+		problems    string
+		replacement string
+	}{
+		{"QB", "bye", "qb2"},
+		{"RB", "inactive,injured", "rb3"}, // Healthy Spears ahead of questionable Warren
+		{"WR", "injured", ""},             // Sutton is Doubtful, so there's no healthy WR left
+		{"WR", "empty", ""},
+		{"FLEX", "inactive", "rb2"},
+	}
+	for i, want := range expected {
+		issue := issues[i]
+		if issue.Slot != want.slot || strings.Join(issue.Problems, ",") != want.problems {
+			t.Errorf("Issue %d: expected %s flagged %s, got %s flagged %v", i, want.slot, want.problems, issue.Slot, issue.Problems)
+		}
+		got := ""
+		if issue.Replacement != nil {
+			got = issue.Replacement.PlayerID
+		}
+		if got != want.replacement {
+			t.Errorf("Issue %d: expected replacement %q, got %q", i, want.replacement, got)
+		}
+	}
+
+	if issues[0].Detail != "Jared Goff (QB, DET) is on bye in week 5" {
+		t.Errorf("Unexpected bye detail: %s", issues[0].Detail)
+	}
+	if issues[4].Detail != "Mike Williams (WR, FA) is not on an NFL team" {
+		t.Errorf("Unexpected free agent detail: %s", issues[4].Detail)
+	}
+
+	// Without a schedule, byes aren't flagged
+	if issues := checkRosterLineup(roster, players, slots, nil, 5); issues[0].Slot == "QB" {
+		t.Errorf("Expected no bye check without a schedule, got %+v", issues[0])
+	}
+}
+
+func TestRosterHandler_HandleCheckLineups(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	client := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID:        leagueID,
+				Season:          "2024",
+				RosterPositions: []string{"QB", "WR", "WR", "BN", "BN"},
+				Settings:        sleeper.LeagueSettings{StartWeek: 1, PlayoffWeekStart: 15, LastScoredLeg: 4},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, OwnerID: "user1", Players: []string{"qb1", "wr1", "wr2", "wr3"}, Starters: []string{"qb1", "wr1", "wr2"}},
+				{RosterID: 2, OwnerID: "user2", Players: []string{"wr3"}, Starters: []string{"0", "wr3", "0"}},
+			}, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{{UserID: "user1", DisplayName: "Sam"}, {UserID: "user2", DisplayName: "Alex"}}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			// Goff's DET bye is week 5 with no other QB rostered
+			return map[string]sleeper.Player{
+				"qb1": {PlayerID: "qb1", FullName: "Jared Goff", Position: "QB", Team: "DET", Status: "Active"},
+				"wr1": {PlayerID: "wr1", FullName: "George Pickens", Position: "WR", Team: "PIT", Status: "Active"},
+				"wr2": {PlayerID: "wr2", FullName: "Deebo Samuel", Position: "WR", Team: "SF", Status: "Active"},
+				"wr3": {PlayerID: "wr3", FullName: "Tyler Lockett", Position: "WR", Team: "SEA", Status: "Active"},
+			}, nil
+		},
+	}
+	handler := NewRosterHandler(client, logger)
+	handler.schedule = bundledScheduleLoader(t)
+
+	result, err := handler.HandleCheckLineups(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool             `json:"success"`
+		Data    LineupCompliance `json:"data"`
+		Summary string           `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	compliance := response.Data
+	if compliance.Week != 5 || !compliance.ByesChecked || compliance.TeamsWithIssues != 2 {
+		t.Fatalf("Expected 2 teams flagged for week 5 with byes checked, got %+v", compliance)
+	}
+	if alex := compliance.Rosters[0]; alex.RosterID != 2 || alex.OwnerName != "Alex" || len(alex.Issues) != 2 {
+		t.Errorf("Expected Alex's two empty slots listed first, got %+v", alex)
+	}
+	if sam := compliance.Rosters[1]; len(sam.Issues) != 1 || sam.Issues[0].Problems[0] != LineupProblemBye || sam.Issues[0].Replacement != nil {
+		t.Errorf("Expected Sam's QB on bye with no backup, got %+v", sam)
+	}
+	if response.Summary != "2 of 2 teams have lineup issues for week 5: Alex (2), Sam (1)" {
+		t.Errorf("Unexpected summary: %s", response.Summary)
+	}
+
+	result, err = handler.HandleCheckLineups(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(9),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for an unknown roster")
+	}
+
+	// Once week 18 is scored there's no upcoming week to default to
+	client.GetLeagueFunc = func(leagueID string) (*sleeper.League, error) {
+		return &sleeper.League{LeagueID: leagueID, Season: "2024", Settings: sleeper.LeagueSettings{StartWeek: 1, LastScoredLeg: 18}}, nil
+	}
+	result, err = handler.HandleCheckLineups(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result after the final week is scored")
+	}
+}
+
+func TestBenchPreferred(t *testing.T) {
+	healthy := sleeper.Player{SearchRank: 9999999}
+	questionable := sleeper.Player{SearchRank: 5, InjuryStatus: "Questionable"}
+	ranked := sleeper.Player{SearchRank: 400}
+	unranked := sleeper.Player{}
+
+	if !benchPreferred(healthy, questionable) || benchPreferred(questionable, healthy) {
+		t.Error("Expected a healthy irrelevant player ahead of a questionable star")
+	}
+	if !benchPreferred(ranked, healthy) || !benchPreferred(ranked, unranked) {
+		t.Error("Expected a ranked player ahead of irrelevant and unranked players")
+	}
+	if benchPreferred(unranked, healthy) || benchPreferred(healthy, unranked) {
+		t.Error("Expected unranked players to tie with Sleeper's irrelevant rank")
+	}
+}
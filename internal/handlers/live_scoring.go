@@ -0,0 +1,350 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/nfl"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Player statuses for live scoring
+const (
+	LiveStatusPlayed     = "played"
+	LiveStatusInProgress = "in_progress"
+	LiveStatusYetToPlay  = "yet_to_play"
+	LiveStatusBye        = "bye"
+)
+
+// Week statuses for live scoring
+const (
+	WeekStatusUpcoming   = "upcoming"
+	WeekStatusNotStarted = "not_started"
+	WeekStatusInProgress = "in_progress"
+	WeekStatusComplete   = "complete"
+)
+
+// LivePlayer represents a starter's live scoring line
+type LivePlayer struct {
+	MatchupPlayer
+	Status          string   `json:"status"`                     // "played", "in_progress", "yet_to_play" or "bye"
+	ProjectedPoints *float64 `json:"projected_points,omitempty"` // Projected final for this player
+}
+
+// LiveTeam represents one side of a live matchup
+type LiveTeam struct {
+	RosterID          int          `json:"roster_id"`
+	DisplayName       string       `json:"display_name,omitempty"`
+	TeamName          string       `json:"team_name,omitempty"`
+	Points            float64      `json:"points"`
+	ProjectedFinal    *float64     `json:"projected_final,omitempty"`
+	PlayersPlayed     int          `json:"players_played"`
+	PlayersInProgress int          `json:"players_in_progress"`
+	PlayersYetToPlay  int          `json:"players_yet_to_play"`
+	Played            []LivePlayer `json:"played"`
+	InProgress        []LivePlayer `json:"in_progress"` // Starters whose games have started but the week isn't over
+	YetToPlay         []LivePlayer `json:"yet_to_play"`
+	OnBye             []LivePlayer `json:"on_bye,omitempty"` // Starters whose NFL team is on bye
+}
+
+// LiveMatchup represents a head-to-head matchup while games are being played
+type LiveMatchup struct {
+	MatchupID         int        `json:"matchup_id"`
+	Teams             []LiveTeam `json:"teams"` // Current leader first
+	LeaderRosterID    int        `json:"leader_roster_id,omitempty"`
+	Margin            float64    `json:"margin"`
+	ProjectedWinnerID int        `json:"projected_winner_roster_id,omitempty"`
+}
+
+// LiveScoreboard represents the live view of a week's matchups
+type LiveScoreboard struct {
+	Season               string        `json:"season"`
+	SeasonType           string        `json:"season_type"`
+	Week                 int           `json:"week"`
+	WeekStatus           string        `json:"week_status"` // "upcoming", "not_started", "in_progress", "complete"
+	InProgress           bool          `json:"in_progress"`
+	ProjectionsAvailable bool          `json:"projections_available"`
+	Matchups             []LiveMatchup `json:"matchups"`
+	AsOf                 time.Time     `json:"as_of"`
+}
+
+// GetLiveMatchupsTool returns the MCP tool definition for get_live_matchups
+func (h *LeagueHandler) GetLiveMatchupsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_live_matchups",
+		Description: "Get live scores for the current week: whether games are in progress, each team's players who have already played, are playing, are yet to play or are on bye, and projected final scores when projections are available. Responses are cached briefly, so polling is cheap.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Week number (default: current NFL week)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetLiveMatchups handles the get_live_matchups tool call
+func (h *LeagueHandler) HandleGetLiveMatchups(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_live_matchups")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	state, err := h.client.GetNFLState()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get NFL state")
+		return toolErrorResult(fmt.Sprintf("Failed to get NFL state: %s", err.Error())), nil
+	}
+	apiCalls := 1
+
+	week := state.Week
+	if weekFloat, ok := args["week"].(float64); ok {
+		week = int(weekFloat)
+		if week < 1 || week > 18 {
+			return nil, fmt.Errorf("week must be between 1 and 18")
+		}
+	} else if week < 1 || week > 18 {
+		return toolErrorResult(fmt.Sprintf("No regular season week is being played (NFL week %d); pass a week to view", week)), nil
+	}
+
+	matchups, err := h.client.GetMatchups(leagueID, week)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get matchups")
+		return toolErrorResult(fmt.Sprintf("Failed to get matchups: %s", err.Error())), nil
+	}
+	apiCalls++
+
+	matchupCtx := h.loadMatchupContext(leagueID, true)
+	apiCalls += matchupCtx.apiCalls
+
+	season := state.Season
+	if matchupCtx.league != nil && matchupCtx.league.Season != "" {
+		season = matchupCtx.league.Season
+	}
+	seasonType := state.SeasonType
+	if seasonType == "" || seasonType == "pre" {
+		seasonType = "regular"
+	}
+
+	stats, err := h.client.GetWeeklyStats(seasonType, season, week)
+	apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get weekly stats, using scored points to detect who has played")
+	}
+
+	projections, err := h.client.GetProjections(seasonType, season, week)
+	apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get projections, projected finals unavailable")
+	}
+
+	scoreboard := buildLiveScoreboard(state, season, week, matchups, matchupCtx, stats, projections, h.schedule.season(season))
+
+	summary := fmt.Sprintf("Week %d is %s: %d matchups", week, scoreboard.WeekStatus, len(scoreboard.Matchups))
+	if len(scoreboard.Matchups) > 0 {
+		closest := scoreboard.Matchups[0]
+		for _, matchup := range scoreboard.Matchups {
+			if matchup.Margin < closest.Margin {
+				closest = matchup
+			}
+		}
+		summary += fmt.Sprintf(", closest: %s vs %s by %.2f",
+			closest.Teams[0].DisplayName, closest.Teams[1].DisplayName, closest.Margin)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    scoreboard,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildLiveScoreboard splits each team's starters into played, in progress, yet to play
+// and on bye, projecting final scores when projections are available. The schedule may be nil.
+func buildLiveScoreboard(state *sleeper.NFLState, season string, week int, matchups []sleeper.Matchup, mc *matchupContext, stats, projections map[string]sleeper.PlayerStats, schedule *nfl.SeasonSchedule) *LiveScoreboard {
+	scoreboard := &LiveScoreboard{
+		Season:               season,
+		SeasonType:           state.SeasonType,
+		Week:                 week,
+		ProjectionsAvailable: len(projections) > 0,
+		AsOf:                 time.Now(),
+	}
+
+	var scoringSettings map[string]float64
+	if mc.league != nil {
+		scoringSettings = mc.league.ScoringSettings
+	}
+
+	weekly := buildWeeklyMatchups(week, matchups, mc)
+	startedTeams := teamsWithStats(stats, mc.players)
+	weekComplete := liveWeekStatus(state, season, week, false) == WeekStatusComplete
+	anyPlayed := false
+
+	for _, pairing := range weekly.Matchups {
+		live := LiveMatchup{
+			MatchupID: pairing.MatchupID,
+			Margin:    pairing.Margin,
+		}
+		if pairing.WinnerRosterID != 0 {
+			live.LeaderRosterID = pairing.WinnerRosterID
+		}
+
+		for _, team := range pairing.Teams {
+			liveTeam := LiveTeam{
+				RosterID:    team.RosterID,
+				DisplayName: team.DisplayName,
+				TeamName:    team.TeamName,
+				Points:      team.Points,
+			}
+			projectedFinal := 0.0
+
+			for _, starter := range team.Starters {
+				if starter.PlayerID == "" || starter.PlayerID == "0" {
+					continue
+				}
+
+				livePlayer := LivePlayer{MatchupPlayer: starter}
+				livePlayer.Status = livePlayerStatus(starter, week, weekComplete, stats, startedTeams, schedule)
+				switch livePlayer.Status {
+				case LiveStatusPlayed:
+					anyPlayed = true
+					projected := starter.Points
+					livePlayer.ProjectedPoints = &projected
+					projectedFinal += starter.Points
+					liveTeam.Played = append(liveTeam.Played, livePlayer)
+				case LiveStatusInProgress:
+					// Partial points understate a player mid-game, so keep the projection until they pass it
+					anyPlayed = true
+					projected := starter.Points
+					if projection, exists := projections[starter.PlayerID]; exists {
+						projected = math.Max(projected, scorePlayerStats(projection, scoringSettings))
+					}
+					livePlayer.ProjectedPoints = &projected
+					projectedFinal += projected
+					liveTeam.InProgress = append(liveTeam.InProgress, livePlayer)
+				case LiveStatusBye:
+					projected := 0.0
+					livePlayer.ProjectedPoints = &projected
+					liveTeam.OnBye = append(liveTeam.OnBye, livePlayer)
+				default:
+					if projection, exists := projections[starter.PlayerID]; exists {
+						projected := scorePlayerStats(projection, scoringSettings)
+						livePlayer.ProjectedPoints = &projected
+						projectedFinal += projected
+					}
+					liveTeam.YetToPlay = append(liveTeam.YetToPlay, livePlayer)
+				}
+			}
+
+			liveTeam.PlayersPlayed = len(liveTeam.Played)
+			liveTeam.PlayersInProgress = len(liveTeam.InProgress)
+			liveTeam.PlayersYetToPlay = len(liveTeam.YetToPlay)
+			if scoreboard.ProjectionsAvailable {
+				rounded := math.Round(projectedFinal*100) / 100
+				liveTeam.ProjectedFinal = &rounded
+			}
+			live.Teams = append(live.Teams, liveTeam)
+		}
+
+		if len(live.Teams) == 2 && live.Teams[0].ProjectedFinal != nil && live.Teams[1].ProjectedFinal != nil {
+			if *live.Teams[0].ProjectedFinal > *live.Teams[1].ProjectedFinal {
+				live.ProjectedWinnerID = live.Teams[0].RosterID
+			} else if *live.Teams[1].ProjectedFinal > *live.Teams[0].ProjectedFinal {
+				live.ProjectedWinnerID = live.Teams[1].RosterID
+			}
+		}
+
+		scoreboard.Matchups = append(scoreboard.Matchups, live)
+	}
+
+	scoreboard.WeekStatus = liveWeekStatus(state, season, week, anyPlayed)
+	scoreboard.InProgress = scoreboard.WeekStatus == WeekStatusInProgress
+
+	return scoreboard
+}
+
+// livePlayerStatus reports whether a starter has played, is playing, is yet to play or
+// is on bye. A starter's game has started once they have points or a stat line, or once
+// anyone on their NFL team has recorded stats, so players held scoreless still count.
+// Without game clocks, a started game is only treated as final once the week is complete.
+func livePlayerStatus(player MatchupPlayer, week int, weekComplete bool, stats map[string]sleeper.PlayerStats, startedTeams map[string]bool, schedule *nfl.SeasonSchedule) string {
+	started := LiveStatusInProgress
+	if weekComplete {
+		started = LiveStatusPlayed
+	}
+
+	if player.Points != 0 || hasStats(stats[player.PlayerID]) {
+		return started
+	}
+	if player.Team == "" {
+		return LiveStatusYetToPlay
+	}
+	if game, known := schedule.Game(player.Team, week); known && game.Bye {
+		return LiveStatusBye
+	}
+	if startedTeams[player.Team] {
+		return started
+	}
+	return LiveStatusYetToPlay
+}
+
+// teamsWithStats returns the NFL teams with at least one player who has recorded
+// stats this week, meaning their game has started
+func teamsWithStats(stats map[string]sleeper.PlayerStats, players map[string]sleeper.Player) map[string]bool {
+	teams := make(map[string]bool)
+	for playerID, playerStats := range stats {
+		if player, exists := players[playerID]; exists && player.Team != "" && hasStats(playerStats) {
+			teams[player.Team] = true
+		}
+	}
+	return teams
+}
+
+// hasStats reports whether a stat line records anything
+func hasStats(playerStats sleeper.PlayerStats) bool {
+	for _, value := range playerStats {
+		if value != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// liveWeekStatus compares the requested week to the NFL state
+func liveWeekStatus(state *sleeper.NFLState, season string, week int, anyPlayed bool) string {
+	if state.Season != "" && season != "" && season < state.Season {
+		return WeekStatusComplete
+	}
+	switch {
+	case week < state.Week:
+		return WeekStatusComplete
+	case week > state.Week:
+		return WeekStatusUpcoming
+	case anyPlayed:
+		return WeekStatusInProgress
+	default:
+		return WeekStatusNotStarted
+	}
+}
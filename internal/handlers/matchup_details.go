@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// MatchupPlayer represents a single player's line in a matchup
+type MatchupPlayer struct {
+	PlayerID string  `json:"player_id"`
+	Name     string  `json:"name"`
+	Position string  `json:"position"`
+	Team     string  `json:"team,omitempty"`
+	Slot     string  `json:"slot,omitempty"` // Lineup slot the player started in
+	Points   float64 `json:"points"`
+}
+
+// MatchupTeam represents one side of a matchup joined to its owner
+type MatchupTeam struct {
+	RosterID    int             `json:"roster_id"`
+	OwnerID     string          `json:"owner_id,omitempty"`
+	DisplayName string          `json:"display_name,omitempty"`
+	TeamName    string          `json:"team_name,omitempty"`
+	Points      float64         `json:"points"`
+	BenchPoints float64         `json:"bench_points"`
+	Result      string          `json:"result,omitempty"` // "W", "L", "T"
+	Starters    []MatchupPlayer `json:"starters"`
+	Bench       []MatchupPlayer `json:"bench,omitempty"`
+}
+
+// MatchupPairing represents two teams paired by matchup_id
+type MatchupPairing struct {
+	MatchupID      int           `json:"matchup_id"`
+	Teams          []MatchupTeam `json:"teams"` // Higher score first
+	Margin         float64       `json:"margin"`
+	WinnerRosterID int           `json:"winner_roster_id,omitempty"`
+}
+
+// WeeklyScore identifies a team's score for the week
+type WeeklyScore struct {
+	RosterID    int     `json:"roster_id"`
+	DisplayName string  `json:"display_name,omitempty"`
+	TeamName    string  `json:"team_name,omitempty"`
+	Points      float64 `json:"points"`
+}
+
+// WeeklyMatchups represents a week's matchups joined with owners and players
+type WeeklyMatchups struct {
+	Week      int              `json:"week"`
+	Matchups  []MatchupPairing `json:"matchups"`
+	Unpaired  []MatchupTeam    `json:"unpaired,omitempty"` // Teams without an opponent (e.g. playoff byes)
+	HighScore *WeeklyScore     `json:"high_score,omitempty"`
+	LowScore  *WeeklyScore     `json:"low_score,omitempty"`
+}
+
+// matchupContext holds the league data used to enrich matchups
+type matchupContext struct {
+	league   *sleeper.League
+	rosters  map[int]sleeper.Roster
+	users    map[string]sleeper.User
+	players  map[string]sleeper.Player
+	apiCalls int
+}
+
+// loadMatchupContext fetches rosters, users and players to enrich matchups.
+// Failures are logged and leave the matching lookup empty so raw IDs are still returned.
+func (h *LeagueHandler) loadMatchupContext(leagueID string, includePlayers bool) *matchupContext {
+	mc := &matchupContext{
+		rosters: make(map[int]sleeper.Roster),
+		users:   make(map[string]sleeper.User),
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	mc.apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league info for matchups")
+	} else {
+		mc.league = league
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	mc.apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get rosters for matchups")
+	}
+	for _, roster := range rosters {
+		mc.rosters[roster.RosterID] = roster
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	mc.apiCalls++
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get users for matchups")
+	}
+	for _, user := range users {
+		mc.users[user.UserID] = user
+	}
+
+	if includePlayers {
+		players, err := h.client.GetAllPlayers()
+		mc.apiCalls++
+		if err != nil {
+			h.logger.WithError(err).Warn("Failed to get players for matchups")
+		}
+		mc.players = players
+	}
+
+	return mc
+}
+
+// owner returns the owner's user ID, display name and team name for a roster
+func (mc *matchupContext) owner(rosterID int) (string, string, string) {
+	roster, exists := mc.rosters[rosterID]
+	if !exists {
+		return "", "", ""
+	}
+
+	user := mc.users[roster.OwnerID]
+	displayName := user.DisplayName
+	if displayName == "" {
+		displayName = user.Username
+	}
+	return roster.OwnerID, displayName, user.Metadata.TeamName
+}
+
+// stashedPlayers returns the roster's IR and taxi players, who can't be started
+func (mc *matchupContext) stashedPlayers(rosterID int) map[string]bool {
+	roster := mc.rosters[rosterID]
+	stashed := make(map[string]bool)
+	for _, playerID := range roster.Reserve {
+		stashed[playerID] = true
+	}
+	for _, playerID := range roster.Taxi {
+		stashed[playerID] = true
+	}
+	return stashed
+}
+
+// buildWeeklyMatchups pairs a week's matchups and joins them to owners and players
+func buildWeeklyMatchups(week int, matchups []sleeper.Matchup, mc *matchupContext) *WeeklyMatchups {
+	weekly := &WeeklyMatchups{Week: week}
+
+	var startingSlots []string
+	if mc.league != nil {
+		startingSlots = startingRosterSlots(mc.league.RosterPositions)
+	}
+
+	teams := make(map[int]MatchupTeam)
+	for _, matchup := range matchups {
+		teams[matchup.RosterID] = buildMatchupTeam(matchup, startingSlots, mc)
+	}
+
+	groups := groupMatchupsByID(matchups)
+	paired := make(map[int]bool)
+	for matchupID, group := range groups {
+		if len(group) != 2 {
+			continue
+		}
+
+		team1, team2 := teams[group[0].RosterID], teams[group[1].RosterID]
+		if team2.Points > team1.Points {
+			team1, team2 = team2, team1
+		}
+
+		pairing := MatchupPairing{
+			MatchupID: matchupID,
+			Margin:    math.Round((team1.Points-team2.Points)*100) / 100,
+		}
+		team1.Result, team2.Result = gameResult(team1.Points, team2.Points), gameResult(team2.Points, team1.Points)
+		if team1.Result == "W" {
+			pairing.WinnerRosterID = team1.RosterID
+		}
+		pairing.Teams = []MatchupTeam{team1, team2}
+
+		weekly.Matchups = append(weekly.Matchups, pairing)
+		paired[team1.RosterID] = true
+		paired[team2.RosterID] = true
+	}
+
+	sort.Slice(weekly.Matchups, func(i, j int) bool {
+		return weekly.Matchups[i].MatchupID < weekly.Matchups[j].MatchupID
+	})
+
+	for _, matchup := range matchups {
+		if !paired[matchup.RosterID] {
+			weekly.Unpaired = append(weekly.Unpaired, teams[matchup.RosterID])
+		}
+	}
+
+	// Weekly high and low scores among teams that played
+	for _, pairing := range weekly.Matchups {
+		for _, team := range pairing.Teams {
+			score := &WeeklyScore{
+				RosterID:    team.RosterID,
+				DisplayName: team.DisplayName,
+				TeamName:    team.TeamName,
+				Points:      team.Points,
+			}
+			if weekly.HighScore == nil || team.Points > weekly.HighScore.Points {
+				weekly.HighScore = score
+			}
+			if weekly.LowScore == nil || team.Points < weekly.LowScore.Points {
+				weekly.LowScore = score
+			}
+		}
+	}
+
+	return weekly
+}
+
+// buildMatchupTeam joins one team's matchup row to its owner and player details
+func buildMatchupTeam(matchup sleeper.Matchup, startingSlots []string, mc *matchupContext) MatchupTeam {
+	ownerID, displayName, teamName := mc.owner(matchup.RosterID)
+	team := MatchupTeam{
+		RosterID:    matchup.RosterID,
+		OwnerID:     ownerID,
+		DisplayName: displayName,
+		TeamName:    teamName,
+		Points:      matchupPoints(matchup),
+	}
+
+	starterSet := make(map[string]bool)
+	for i, playerID := range matchup.Starters {
+		starterSet[playerID] = true
+
+		points := matchup.PlayersPoints[playerID]
+		if i < len(matchup.StartersPoints) {
+			points = matchup.StartersPoints[i]
+		}
+
+		player := mc.matchupPlayer(playerID, points)
+		if i < len(startingSlots) {
+			player.Slot = startingSlots[i]
+		}
+		team.Starters = append(team.Starters, player)
+	}
+
+	stashed := mc.stashedPlayers(matchup.RosterID)
+	for _, playerID := range matchup.Players {
+		if starterSet[playerID] || stashed[playerID] {
+			continue
+		}
+		player := mc.matchupPlayer(playerID, matchup.PlayersPoints[playerID])
+		team.Bench = append(team.Bench, player)
+		team.BenchPoints += player.Points
+	}
+	team.BenchPoints = math.Round(team.BenchPoints*100) / 100
+
+	sort.SliceStable(team.Bench, func(i, j int) bool {
+		return team.Bench[i].Points > team.Bench[j].Points
+	})
+
+	return team
+}
+
+// matchupPlayer builds a player's line, falling back to the raw ID when details are missing
+func (mc *matchupContext) matchupPlayer(playerID string, points float64) MatchupPlayer {
+	line := MatchupPlayer{
+		PlayerID: playerID,
+		Name:     playerID,
+		Points:   points,
+	}
+	if playerID == "" || playerID == "0" {
+		line.Name = "Empty slot"
+		return line
+	}
+
+	if player, exists := mc.players[playerID]; exists {
+		line.Name = playerDisplayName(player)
+		line.Position = player.Position
+		line.Team = player.Team
+	}
+	return line
+}
+
+// playerDisplayName returns a player's full name, building it for team defenses
+func playerDisplayName(player sleeper.Player) string {
+	if player.FullName != "" {
+		return player.FullName
+	}
+	if name := strings.TrimSpace(player.FirstName + " " + player.LastName); name != "" {
+		return name
+	}
+	return player.PlayerID
+}
+
+// startingRosterSlots returns the league's lineup slots, excluding bench, IR and taxi
+func startingRosterSlots(rosterPositions []string) []string {
+	var slots []string
+	for _, position := range rosterPositions {
+		switch position {
+		case "BN", "IR", "TAXI":
+			continue
+		}
+		slots = append(slots, position)
+	}
+	return slots
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// OwnerSeason represents one owner's finish in a single season
+type OwnerSeason struct {
+	Season            string  `json:"season"`
+	LeagueID          string  `json:"league_id"`
+	RosterID          int     `json:"roster_id"`
+	TeamName          string  `json:"team_name,omitempty"`
+	Wins              int     `json:"wins"`
+	Losses            int     `json:"losses"`
+	Ties              int     `json:"ties"`
+	PointsFor         float64 `json:"points_for"`
+	PointsAgainst     float64 `json:"points_against"`
+	RegularSeasonRank int     `json:"regular_season_rank"`
+	FinalRank         int     `json:"final_rank,omitempty"` // Only for completed seasons with playoff results
+	PlayoffSeed       int     `json:"playoff_seed,omitempty"`
+	PlayoffOutcome    string  `json:"playoff_outcome,omitempty"`
+	Complete          bool    `json:"complete"`
+}
+
+// OwnerCareer represents an owner's aggregated career across league history
+type OwnerCareer struct {
+	OwnerID            string        `json:"owner_id"`
+	DisplayName        string        `json:"display_name"`
+	SeasonsPlayed      int           `json:"seasons_played"`
+	Wins               int           `json:"wins"`
+	Losses             int           `json:"losses"`
+	Ties               int           `json:"ties"`
+	WinPercentage      float64       `json:"win_percentage"`
+	PointsFor          float64       `json:"points_for"`
+	PointsAgainst      float64       `json:"points_against"`
+	PlayoffAppearances int           `json:"playoff_appearances"`
+	Titles             int           `json:"titles"`
+	RunnerUps          int           `json:"runner_ups"`
+	LastPlaceFinishes  int           `json:"last_place_finishes"`
+	BestFinish         int           `json:"best_finish,omitempty"`
+	NameHistory        []NameChange  `json:"name_history,omitempty"` // Only when the owner has gone by more than one name
+	CoOwnerIDs         []string      `json:"co_owner_ids,omitempty"`
+	Seasons            []OwnerSeason `json:"seasons"`
+}
+
+// ChampionshipSeason represents the title game result and last place for a season
+type ChampionshipSeason struct {
+	Season      string `json:"season"`
+	ChampionID  string `json:"champion_id,omitempty"`
+	Champion    string `json:"champion,omitempty"`
+	RunnerUpID  string `json:"runner_up_id,omitempty"`
+	RunnerUp    string `json:"runner_up,omitempty"`
+	LastPlaceID string `json:"last_place_id,omitempty"`
+	LastPlace   string `json:"last_place,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// OwnerCareers represents every owner's career and the league's championship history
+type OwnerCareers struct {
+	LeagueName          string               `json:"league_name"`
+	Seasons             []string             `json:"seasons"`
+	Owners              []OwnerCareer        `json:"owners"`
+	ChampionshipHistory []ChampionshipSeason `json:"championship_history"`
+	Warnings            []string             `json:"warnings,omitempty"`
+}
+
+// GetOwnerCareersTool returns the MCP tool definition for get_owner_careers
+func (h *LeagueHandler) GetOwnerCareersTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_owner_careers",
+		Description: "Get each owner's career across league history: seasons played, W/L/T, win percentage, points for and against, playoff appearances, titles, runner-ups and last-place finishes, plus the championship history by season",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Current league ID (will auto-discover historical seasons)",
+					"required":    true,
+				},
+				"owner": map[string]interface{}{
+					"type":        "string",
+					"description": "Only return this owner's career (user ID, display name or username)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetOwnerCareers handles the get_owner_careers tool call
+func (h *LeagueHandler) HandleGetOwnerCareers(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_owner_careers")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+	ownerQuery, _ := args["owner"].(string)
+
+	leagueGroup, err := h.discoverLeagueHistory(leagueID, 10)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to discover league history")
+		return toolErrorResult(fmt.Sprintf("Failed to discover league history: %s", err.Error())), nil
+	}
+	registry, warnings, registryCalls := h.loadOwnerRegistry(leagueGroup, leagueID)
+	apiCalls := calculateAPICalls(leagueGroup) + registryCalls
+
+	seasons := make([]SeasonData, 0, len(leagueGroup.Seasons))
+	for _, seasonData := range leagueGroup.Seasons {
+		seasons = append(seasons, seasonData)
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		return seasons[i].Season < seasons[j].Season
+	})
+
+	standingsBySeason := make(map[string][]StandingEntry)
+	for _, seasonData := range seasons {
+		standings, calls, err := h.seasonFinalStandings(seasonData, leagueID)
+		apiCalls += calls
+		if err != nil {
+			h.logger.WithError(err).WithField("season", seasonData.Season).Warn("Failed to compute season standings")
+			warnings = append(warnings, fmt.Sprintf("%s: %s", seasonData.Season, err.Error()))
+			continue
+		}
+		registry.applyToStandings(seasonData.Season, standings)
+		standingsBySeason[seasonData.Season] = standings
+	}
+
+	careers := buildOwnerCareers(leagueGroup.Name, seasons, standingsBySeason, registry)
+	careers.Warnings = append(careers.Warnings, warnings...)
+
+	if ownerQuery != "" {
+		ownerID, err := registry.resolve(ownerQuery)
+		if err != nil {
+			return toolErrorResult(err.Error()), nil
+		}
+
+		var filtered []OwnerCareer
+		for _, career := range careers.Owners {
+			if career.OwnerID == ownerID {
+				filtered = append(filtered, career)
+			}
+		}
+		careers.Owners = filtered
+	}
+
+	summary := fmt.Sprintf("Career stats for %d owners across %d seasons", len(careers.Owners), len(careers.Seasons))
+	if len(careers.Owners) > 0 && careers.Owners[0].Titles > 0 {
+		summary += fmt.Sprintf(", most titles: %s (%d)", careers.Owners[0].DisplayName, careers.Owners[0].Titles)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    careers,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// seasonFinalStandings computes a season's standings, using playoff results for
+// completed seasons and regular season standings otherwise. Seasons without their
+// own configuration use currentLeagueID's tiebreakers and seeding policy.
+func (h *LeagueHandler) seasonFinalStandings(seasonData SeasonData, currentLeagueID string) ([]StandingEntry, int, error) {
+	mode := "regular_season"
+	if seasonData.League != nil && seasonData.League.Status == "complete" {
+		mode = "final"
+	}
+
+	return h.computeStandings(seasonData.LeagueID, standingsRequest{Mode: mode, ConfigLeagueID: currentLeagueID})
+}
+
+// buildOwnerCareers aggregates each owner's seasons into career totals. Standings
+// owner IDs are expected to already be registry owners.
+func buildOwnerCareers(leagueName string, seasons []SeasonData, standingsBySeason map[string][]StandingEntry, registry *ownerRegistry) *OwnerCareers {
+	careers := &OwnerCareers{LeagueName: leagueName}
+	byOwner := make(map[string]*OwnerCareer)
+
+	for _, seasonData := range seasons {
+		standings, exists := standingsBySeason[seasonData.Season]
+		if !exists {
+			continue
+		}
+		careers.Seasons = append(careers.Seasons, seasonData.Season)
+
+		// Final placements only exist when playoff results were applied
+		complete := seasonData.League != nil && seasonData.League.Status == "complete"
+		hasPlayoffResults := false
+		for _, entry := range standings {
+			if entry.PlayoffOutcome != "" {
+				hasPlayoffResults = true
+				break
+			}
+		}
+
+		championship := ChampionshipSeason{Season: seasonData.Season}
+		for i, entry := range standings {
+			if entry.OwnerID == "" {
+				continue
+			}
+
+			career := byOwner[entry.OwnerID]
+			if career == nil {
+				career = &OwnerCareer{OwnerID: entry.OwnerID}
+				byOwner[entry.OwnerID] = career
+			}
+			if entry.DisplayName != "" {
+				career.DisplayName = entry.DisplayName // Later seasons win, so the most recent name is kept
+			}
+
+			ownerSeason := OwnerSeason{
+				Season:            seasonData.Season,
+				LeagueID:          seasonData.LeagueID,
+				RosterID:          entry.RosterID,
+				TeamName:          entry.TeamName,
+				Wins:              entry.Wins,
+				Losses:            entry.Losses,
+				Ties:              entry.Ties,
+				PointsFor:         entry.PointsFor,
+				PointsAgainst:     entry.PointsAgainst,
+				RegularSeasonRank: entry.Rank,
+				PlayoffSeed:       entry.PlayoffSeed,
+				PlayoffOutcome:    entry.PlayoffOutcome,
+				Complete:          complete,
+			}
+			if hasPlayoffResults {
+				ownerSeason.RegularSeasonRank = entry.RegularSeasonRank
+				ownerSeason.FinalRank = entry.Rank
+			}
+
+			career.SeasonsPlayed++
+			career.Wins += entry.Wins
+			career.Losses += entry.Losses
+			career.Ties += entry.Ties
+			career.PointsFor += entry.PointsFor
+			career.PointsAgainst += entry.PointsAgainst
+			if complete {
+				if entry.PlayoffSeed > 0 {
+					career.PlayoffAppearances++
+				}
+
+				switch entry.PlayoffOutcome {
+				case "champion":
+					career.Titles++
+					championship.ChampionID, championship.Champion = entry.OwnerID, entry.DisplayName
+				case "runner_up":
+					career.RunnerUps++
+					championship.RunnerUpID, championship.RunnerUp = entry.OwnerID, entry.DisplayName
+				}
+				if i == len(standings)-1 {
+					career.LastPlaceFinishes++
+					championship.LastPlaceID, championship.LastPlace = entry.OwnerID, entry.DisplayName
+				}
+
+				finish := entry.Rank
+				if career.BestFinish == 0 || finish < career.BestFinish {
+					career.BestFinish = finish
+				}
+			}
+
+			career.Seasons = append(career.Seasons, ownerSeason)
+		}
+
+		switch {
+		case !complete:
+			championship.Note = "Season in progress"
+		case !hasPlayoffResults:
+			championship.Note = "Playoff results unavailable, placements use regular season standings"
+		}
+		careers.ChampionshipHistory = append(careers.ChampionshipHistory, championship)
+	}
+
+	for _, career := range byOwner {
+		if identity := registry.owners[career.OwnerID]; identity != nil {
+			if identity.DisplayName != "" {
+				career.DisplayName = identity.DisplayName
+			}
+			if len(identity.DisplayNames) > 1 {
+				career.NameHistory = identity.DisplayNames
+			}
+			career.CoOwnerIDs = identity.CoOwnerIDs
+		}
+
+		games := career.Wins + career.Losses + career.Ties
+		if games > 0 {
+			career.WinPercentage = math.Round((float64(career.Wins)+float64(career.Ties)*0.5)/float64(games)*1000) / 1000
+		}
+		career.PointsFor = math.Round(career.PointsFor*100) / 100
+		career.PointsAgainst = math.Round(career.PointsAgainst*100) / 100
+		careers.Owners = append(careers.Owners, *career)
+	}
+
+	sort.Slice(careers.Owners, func(i, j int) bool {
+		a, b := careers.Owners[i], careers.Owners[j]
+		if a.Titles != b.Titles {
+			return a.Titles > b.Titles
+		}
+		if a.WinPercentage != b.WinPercentage {
+			return a.WinPercentage > b.WinPercentage
+		}
+		return a.OwnerID < b.OwnerID
+	})
+
+	return careers
+}
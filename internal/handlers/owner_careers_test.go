@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// newCareersMockClient builds an 8-team league with a completed 2023 season, won by
+// roster 5 through a 6-team bracket, and a 2024 season still in progress
+func newCareersMockClient() *MockSleeperClient {
+	var users []sleeper.User
+	var rosters []sleeper.Roster
+	for i := 1; i <= 8; i++ {
+		userID := fmt.Sprintf("user%d", i)
+		users = append(users, sleeper.User{UserID: userID, DisplayName: fmt.Sprintf("Player %d", i)})
+		rosters = append(rosters, sleeper.Roster{
+			RosterID: i,
+			OwnerID:  userID,
+			Settings: sleeper.RosterSettings{Wins: 14 - i, Losses: i, FPTS: float64(1500 - i*10)},
+		})
+	}
+
+	leagues := map[string]*sleeper.League{
+		"league2024": {LeagueID: "league2024", Name: "Test League", Season: "2024", Status: "in_season", PreviousLeagueID: "league2023",
+			Settings: sleeper.LeagueSettings{PlayoffTeams: 6, NumTeams: 8}},
+		"league2023": {LeagueID: "league2023", Name: "Test League", Season: "2023", Status: "complete",
+			Settings: sleeper.LeagueSettings{PlayoffTeams: 6, NumTeams: 8}},
+	}
+	placement := func(p int) *int { return &p }
+
+	return &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return leagues[leagueID], nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return users, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return rosters, nil
+		},
+		GetWinnersBracketFunc: func(leagueID string) ([]sleeper.BracketMatchup, error) {
+			return []sleeper.BracketMatchup{
+				{MatchupID: 1, Round: 1, Team1: 3, Team2: 6, Winner: 3, Loser: 6},
+				{MatchupID: 2, Round: 1, Team1: 4, Team2: 5, Winner: 5, Loser: 4},
+				{MatchupID: 3, Round: 2, Team1: 1, Team2: 5, Winner: 5, Loser: 1},
+				{MatchupID: 4, Round: 2, Team1: 2, Team2: 3, Winner: 2, Loser: 3},
+				{MatchupID: 5, Round: 2, Team1: 6, Team2: 4, Winner: 4, Loser: 6, PlayoffWeek: placement(5)},
+				{MatchupID: 6, Round: 3, Team1: 5, Team2: 2, Winner: 5, Loser: 2, PlayoffWeek: placement(1)},
+				{MatchupID: 7, Round: 3, Team1: 1, Team2: 3, Winner: 1, Loser: 3, PlayoffWeek: placement(3)},
+			}, nil
+		},
+	}
+}
+
+func TestLeagueHandler_GetOwnerCareers(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(newCareersMockClient(), logger)
+	result, err := handler.HandleGetOwnerCareers(context.Background(), map[string]interface{}{
+		"league_id": "league2024",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %s", result.Content[0].(*mcp.TextContent).Text)
+	}
+
+	var response struct {
+		Data OwnerCareers `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	careers := response.Data
+
+	if len(careers.ChampionshipHistory) != 2 {
+		t.Fatalf("Expected 2 seasons of championship history, got %d", len(careers.ChampionshipHistory))
+	}
+	season2023 := careers.ChampionshipHistory[0]
+	if season2023.ChampionID != "user5" || season2023.RunnerUpID != "user2" || season2023.LastPlaceID != "user8" {
+		t.Errorf("Expected user5 champion, user2 runner-up and user8 last in 2023, got %+v", season2023)
+	}
+	if careers.ChampionshipHistory[1].ChampionID != "" || careers.ChampionshipHistory[1].Note == "" {
+		t.Errorf("Expected no champion and a note for the in-progress season, got %+v", careers.ChampionshipHistory[1])
+	}
+
+	champion := careers.Owners[0]
+	if champion.OwnerID != "user5" || champion.Titles != 1 {
+		t.Errorf("Expected user5 to lead with 1 title, got %s with %d", champion.OwnerID, champion.Titles)
+	}
+	if champion.SeasonsPlayed != 2 || champion.Wins != 18 || champion.Losses != 10 {
+		t.Errorf("Expected user5 to be 18-10 over 2 seasons, got %d-%d over %d", champion.Wins, champion.Losses, champion.SeasonsPlayed)
+	}
+	if champion.PlayoffAppearances != 1 || champion.BestFinish != 1 {
+		t.Errorf("Expected 1 playoff appearance and a best finish of 1, got %d and %d", champion.PlayoffAppearances, champion.BestFinish)
+	}
+
+	careerByOwner := make(map[string]OwnerCareer)
+	for _, career := range careers.Owners {
+		careerByOwner[career.OwnerID] = career
+	}
+	if careerByOwner["user8"].LastPlaceFinishes != 1 || careerByOwner["user8"].PlayoffAppearances != 0 {
+		t.Errorf("Expected user8 to have 1 last place finish and no playoffs, got %+v", careerByOwner["user8"])
+	}
+	if careerByOwner["user2"].RunnerUps != 1 {
+		t.Errorf("Expected user2 to have 1 runner-up finish, got %d", careerByOwner["user2"].RunnerUps)
+	}
+	if first := careerByOwner["user1"].Seasons[0]; first.FinalRank != 3 || first.RegularSeasonRank != 1 || first.PlayoffOutcome != "third_place" {
+		t.Errorf("Expected user1 to finish 3rd after a 1st place regular season, got %+v", first)
+	}
+}
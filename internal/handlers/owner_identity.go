@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// NameChange records a name in use from a season on
+type NameChange struct {
+	Season string `json:"season"`
+	Name   string `json:"name"`
+}
+
+// FranchiseSeason represents the roster an owner ran in one season
+type FranchiseSeason struct {
+	Season   string `json:"season"`
+	LeagueID string `json:"league_id"`
+	RosterID int    `json:"roster_id"`
+	Override bool   `json:"override,omitempty"` // Assigned by a configured takeover rather than Sleeper's owner_id
+}
+
+// OwnerIdentity represents one owner tracked across seasons, accounts and renames
+type OwnerIdentity struct {
+	OwnerID      string            `json:"owner_id"`
+	DisplayName  string            `json:"display_name"`           // Most recent display name
+	AliasIDs     []string          `json:"alias_ids,omitempty"`    // Other accounts merged into this owner by configuration
+	CoOwnerIDs   []string          `json:"co_owner_ids,omitempty"` // Co-owners whose games count toward this owner
+	DisplayNames []NameChange      `json:"display_names"`
+	TeamNames    []NameChange      `json:"team_names,omitempty"`
+	Franchises   []FranchiseSeason `json:"franchises"`
+}
+
+// FranchiseOwner represents who ran a franchise over a span of seasons
+type FranchiseOwner struct {
+	OwnerID     string `json:"owner_id"`
+	DisplayName string `json:"display_name"`
+	FromSeason  string `json:"from_season"`
+	ToSeason    string `json:"to_season"`
+}
+
+// Franchise represents a roster slot and its owners over time
+type Franchise struct {
+	RosterID int              `json:"roster_id"`
+	Owners   []FranchiseOwner `json:"owners"`
+}
+
+// ownerRegistry resolves Sleeper users and rosters to stable owners across seasons
+type ownerRegistry struct {
+	owners        map[string]*OwnerIdentity
+	accounts      map[string]string           // Any known user ID (owner, alias or co-owner) to owner ID
+	rosterOwners  map[string]map[int]string   // Season to roster ID to owner ID
+	seasonRosters map[string][]sleeper.Roster // Rosters for every season that loaded
+	seasons       []string                    // Oldest first
+	users         []sleeper.User              // Every user from every season, for name lookups
+}
+
+// loadOwnerRegistry fetches every season's rosters and builds the owner registry,
+// applying the current league's configured aliases and takeovers
+func (h *LeagueHandler) loadOwnerRegistry(leagueGroup *LeagueGroup, currentLeagueID string) (*ownerRegistry, []string, int) {
+	seasons := make([]SeasonData, 0, len(leagueGroup.Seasons))
+	for _, seasonData := range leagueGroup.Seasons {
+		seasons = append(seasons, seasonData)
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		return seasons[i].Season < seasons[j].Season
+	})
+
+	var warnings []string
+	apiCalls := 0
+	rostersBySeason := make(map[string][]sleeper.Roster)
+	for _, seasonData := range seasons {
+		rosters, err := h.client.GetLeagueRosters(seasonData.LeagueID)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithField("season", seasonData.Season).Warn("Failed to get rosters for season")
+			warnings = append(warnings, fmt.Sprintf("%s: failed to get rosters (%s)", seasonData.Season, err.Error()))
+			continue
+		}
+		rostersBySeason[seasonData.Season] = rosters
+	}
+
+	var overrides config.OwnerIdentity
+	if h.config != nil {
+		overrides = h.config.GetLeagueSettings(currentLeagueID).Owners
+	}
+
+	return buildOwnerRegistry(seasons, rostersBySeason, overrides), warnings, apiCalls
+}
+
+// buildOwnerRegistry ties each season's rosters to owners. A roster belongs to its
+// Sleeper owner_id (or that account's configured primary account) unless a takeover
+// assigns it to someone else. Co-owners who never own a roster themselves are folded
+// into the roster's owner.
+func buildOwnerRegistry(seasons []SeasonData, rostersBySeason map[string][]sleeper.Roster, overrides config.OwnerIdentity) *ownerRegistry {
+	registry := &ownerRegistry{
+		owners:        make(map[string]*OwnerIdentity),
+		accounts:      make(map[string]string),
+		rosterOwners:  make(map[string]map[int]string),
+		seasonRosters: rostersBySeason,
+	}
+
+	primary := func(userID string) string {
+		if ownerID := overrides.Aliases[userID]; ownerID != "" {
+			return ownerID
+		}
+		return userID
+	}
+
+	takeovers := make([]config.FranchiseTakeover, len(overrides.Takeovers))
+	copy(takeovers, overrides.Takeovers)
+	sort.SliceStable(takeovers, func(i, j int) bool {
+		return takeovers[i].Season < takeovers[j].Season
+	})
+
+	for _, seasonData := range seasons {
+		season := seasonData.Season
+		registry.seasons = append(registry.seasons, season)
+		registry.users = append(registry.users, seasonData.Users...)
+
+		usersByID := make(map[string]sleeper.User)
+		for _, user := range seasonData.Users {
+			usersByID[user.UserID] = user
+		}
+
+		rosters, loaded := rostersBySeason[season]
+		if !loaded {
+			continue
+		}
+		registry.rosterOwners[season] = make(map[int]string)
+
+		for _, roster := range rosters {
+			ownerID := primary(roster.OwnerID)
+			override := false
+			for _, takeover := range takeovers {
+				if takeover.RosterID == roster.RosterID && takeover.Season <= season {
+					ownerID = primary(takeover.UserID)
+					override = true
+				}
+			}
+			if ownerID == "" {
+				continue
+			}
+
+			registry.rosterOwners[season][roster.RosterID] = ownerID
+			registry.accounts[ownerID] = ownerID
+			if !override && roster.OwnerID != "" {
+				registry.accounts[roster.OwnerID] = ownerID
+			}
+
+			identity := registry.owners[ownerID]
+			if identity == nil {
+				identity = &OwnerIdentity{OwnerID: ownerID}
+				registry.owners[ownerID] = identity
+			}
+			identity.Franchises = append(identity.Franchises, FranchiseSeason{
+				Season:   season,
+				LeagueID: seasonData.LeagueID,
+				RosterID: roster.RosterID,
+				Override: override,
+			})
+
+			// Prefer the owner's own account for names, falling back to the roster's account
+			user, exists := usersByID[ownerID]
+			if !exists && !override {
+				user, exists = usersByID[roster.OwnerID]
+			}
+			if !exists {
+				continue
+			}
+			if name := userDisplayName(user); name != "" {
+				identity.DisplayName = name
+				identity.DisplayNames = appendNameChange(identity.DisplayNames, season, name)
+			}
+			if user.Metadata.TeamName != "" {
+				identity.TeamNames = appendNameChange(identity.TeamNames, season, user.Metadata.TeamName)
+			}
+		}
+	}
+
+	// Co-owners are only folded in once every roster owner is known
+	for _, season := range registry.seasons {
+		for _, roster := range rostersBySeason[season] {
+			ownerID := registry.rosterOwners[season][roster.RosterID]
+			if ownerID == "" {
+				continue
+			}
+			for _, coOwnerID := range roster.CoOwners {
+				if _, ownsRoster := registry.owners[primary(coOwnerID)]; ownsRoster || coOwnerID == "" {
+					continue
+				}
+				registry.accounts[coOwnerID] = ownerID
+				identity := registry.owners[ownerID]
+				if !containsString(identity.CoOwnerIDs, coOwnerID) {
+					identity.CoOwnerIDs = append(identity.CoOwnerIDs, coOwnerID)
+				}
+			}
+		}
+	}
+
+	for aliasID, ownerID := range overrides.Aliases {
+		registry.accounts[aliasID] = ownerID
+		if identity := registry.owners[ownerID]; identity != nil {
+			identity.AliasIDs = append(identity.AliasIDs, aliasID)
+			sort.Strings(identity.AliasIDs)
+		}
+	}
+
+	return registry
+}
+
+// userDisplayName returns a user's display name, falling back to their username
+func userDisplayName(user sleeper.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.Username
+}
+
+// appendNameChange records a name if it differs from the most recent one
+func appendNameChange(history []NameChange, season, name string) []NameChange {
+	if len(history) > 0 && history[len(history)-1].Name == name {
+		return history
+	}
+	return append(history, NameChange{Season: season, Name: name})
+}
+
+// containsString checks if a slice contains a string
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+// ownerForRoster returns the owner of a roster in a season, or "" if unknown
+func (r *ownerRegistry) ownerForRoster(season string, rosterID int) string {
+	return r.rosterOwners[season][rosterID]
+}
+
+// ownerForUser maps any known account to its owner ID
+func (r *ownerRegistry) ownerForUser(userID string) string {
+	if ownerID, exists := r.accounts[userID]; exists {
+		return ownerID
+	}
+	return userID
+}
+
+// names maps owner IDs to their most recent display name
+func (r *ownerRegistry) names() map[string]string {
+	names := make(map[string]string)
+	for ownerID, identity := range r.owners {
+		if identity.DisplayName != "" {
+			names[ownerID] = identity.DisplayName
+		}
+	}
+	return names
+}
+
+// applyToStandings replaces Sleeper owner IDs in a season's standings with registry owners
+func (r *ownerRegistry) applyToStandings(season string, standings []StandingEntry) {
+	for i := range standings {
+		if ownerID := r.ownerForRoster(season, standings[i].RosterID); ownerID != "" {
+			standings[i].OwnerID = ownerID
+		}
+	}
+}
+
+// resolve finds an owner from a user ID or any display name or username the owner,
+// their aliases or co-owners have used. Names are matched case-insensitively.
+func (r *ownerRegistry) resolve(query string) (string, error) {
+	if ownerID, exists := r.accounts[query]; exists {
+		return ownerID, nil
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(query))
+	matches := make(map[string]bool)
+	for _, user := range r.users {
+		if user.UserID == query {
+			return r.ownerForUser(user.UserID), nil
+		}
+		if strings.ToLower(user.DisplayName) == normalized || strings.ToLower(user.Username) == normalized {
+			matches[r.ownerForUser(user.UserID)] = true
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no owner found matching %q", query)
+	case 1:
+		for ownerID := range matches {
+			return ownerID, nil
+		}
+	}
+
+	ids := make([]string, 0, len(matches))
+	for ownerID := range matches {
+		ids = append(ids, ownerID)
+	}
+	sort.Strings(ids)
+	return "", fmt.Errorf("%q matches multiple owners (%s), use a user ID", query, strings.Join(ids, ", "))
+}
+
+// identities returns every owner, ordered by display name
+func (r *ownerRegistry) identities() []OwnerIdentity {
+	identities := make([]OwnerIdentity, 0, len(r.owners))
+	for _, identity := range r.owners {
+		identities = append(identities, *identity)
+	}
+	sort.Slice(identities, func(i, j int) bool {
+		a, b := strings.ToLower(identities[i].DisplayName), strings.ToLower(identities[j].DisplayName)
+		if a != b {
+			return a < b
+		}
+		return identities[i].OwnerID < identities[j].OwnerID
+	})
+	return identities
+}
+
+// franchises returns each roster slot's owners over time, merging consecutive seasons
+// under the same owner
+func (r *ownerRegistry) franchises() []Franchise {
+	byRoster := make(map[int]*Franchise)
+	for _, season := range r.seasons {
+		for rosterID, ownerID := range r.rosterOwners[season] {
+			franchise := byRoster[rosterID]
+			if franchise == nil {
+				franchise = &Franchise{RosterID: rosterID}
+				byRoster[rosterID] = franchise
+			}
+
+			if last := len(franchise.Owners) - 1; last >= 0 && franchise.Owners[last].OwnerID == ownerID {
+				franchise.Owners[last].ToSeason = season
+				continue
+			}
+			displayName := ""
+			if identity := r.owners[ownerID]; identity != nil {
+				displayName = identity.DisplayName
+			}
+			franchise.Owners = append(franchise.Owners, FranchiseOwner{
+				OwnerID:     ownerID,
+				DisplayName: displayName,
+				FromSeason:  season,
+				ToSeason:    season,
+			})
+		}
+	}
+
+	franchises := make([]Franchise, 0, len(byRoster))
+	for _, franchise := range byRoster {
+		franchises = append(franchises, *franchise)
+	}
+	sort.Slice(franchises, func(i, j int) bool {
+		return franchises[i].RosterID < franchises[j].RosterID
+	})
+	return franchises
+}
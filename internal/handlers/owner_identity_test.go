@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+func TestBuildOwnerRegistry(t *testing.T) {
+	user := func(userID, displayName, teamName string) sleeper.User {
+		return sleeper.User{UserID: userID, DisplayName: displayName, Metadata: sleeper.UserMetadata{TeamName: teamName}}
+	}
+	seasons := []SeasonData{
+		{Season: "2021", LeagueID: "league2021", Users: []sleeper.User{
+			user("alice", "Alice", "Aces"), user("bob", "Bob", ""), user("carl_old", "Carl", ""),
+		}},
+		{Season: "2022", LeagueID: "league2022", Users: []sleeper.User{
+			user("alice", "AliceTheGreat", "Aces"), user("bob", "Bob", ""), user("carl", "Carl", ""),
+			user("dana", "Dana", ""), user("erin", "Erin", ""),
+		}},
+	}
+	rosters := map[string][]sleeper.Roster{
+		"2021": {{RosterID: 1, OwnerID: "alice"}, {RosterID: 2, OwnerID: "bob"}, {RosterID: 3, OwnerID: "carl_old"}},
+		// Bob left; Dana runs roster 2 from Bob's account until the commissioner reassigns it
+		"2022": {
+			{RosterID: 1, OwnerID: "alice", CoOwners: []string{"erin"}},
+			{RosterID: 2, OwnerID: "bob"},
+			{RosterID: 3, OwnerID: "carl"},
+		},
+	}
+	overrides := config.OwnerIdentity{
+		Aliases:   map[string]string{"carl_old": "carl"},
+		Takeovers: []config.FranchiseTakeover{{UserID: "dana", RosterID: 2, Season: "2022"}},
+	}
+
+	registry := buildOwnerRegistry(seasons, rosters, overrides)
+
+	if owner := registry.ownerForRoster("2021", 2); owner != "bob" {
+		t.Errorf("Expected bob to own roster 2 in 2021, got %q", owner)
+	}
+	if owner := registry.ownerForRoster("2022", 2); owner != "dana" {
+		t.Errorf("Expected the takeover to give roster 2 to dana in 2022, got %q", owner)
+	}
+	if owner := registry.ownerForRoster("2021", 3); owner != "carl" {
+		t.Errorf("Expected carl_old to be merged into carl, got %q", owner)
+	}
+
+	alice := registry.owners["alice"]
+	if alice.DisplayName != "AliceTheGreat" || len(alice.DisplayNames) != 2 || len(alice.TeamNames) != 1 {
+		t.Errorf("Expected 2 display names and 1 team name for alice, got %+v", alice)
+	}
+	if len(alice.CoOwnerIDs) != 1 || alice.CoOwnerIDs[0] != "erin" {
+		t.Errorf("Expected erin as alice's co-owner, got %v", alice.CoOwnerIDs)
+	}
+	if carl := registry.owners["carl"]; len(carl.Franchises) != 2 || len(carl.AliasIDs) != 1 {
+		t.Errorf("Expected carl to have 2 seasons and 1 alias, got %+v", carl)
+	}
+
+	// Old names, co-owners and aliases all resolve to the owner
+	for query, expected := range map[string]string{
+		"alice":    "alice",
+		"Alice":    "alice",
+		"erin":     "alice",
+		"carl_old": "carl",
+		"Carl":     "carl",
+		"dana":     "dana",
+	} {
+		owner, err := registry.resolve(query)
+		if err != nil || owner != expected {
+			t.Errorf("Expected %q to resolve to %s, got %q (%v)", query, expected, owner, err)
+		}
+	}
+	if _, err := registry.resolve("nobody"); err == nil {
+		t.Error("Expected an error for an unknown owner")
+	}
+
+	franchises := registry.franchises()
+	if len(franchises) != 3 {
+		t.Fatalf("Expected 3 franchises, got %d", len(franchises))
+	}
+	if owners := franchises[1].Owners; len(owners) != 2 || owners[0].OwnerID != "bob" || owners[1].OwnerID != "dana" || owners[1].FromSeason != "2022" {
+		t.Errorf("Expected roster 2 to pass from bob to dana in 2022, got %+v", owners)
+	}
+	if owners := franchises[2].Owners; len(owners) != 1 || owners[0].FromSeason != "2021" || owners[0].ToSeason != "2022" {
+		t.Errorf("Expected roster 3 to stay with carl for both seasons, got %+v", owners)
+	}
+}
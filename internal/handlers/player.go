@@ -0,0 +1,727 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus"
+)
+
+// nameSuffixes are dropped when matching names, so "Marvin Harrison" finds "Marvin Harrison Jr."
+var nameSuffixes = map[string]bool{
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "v": true,
+}
+
+// firstNameNicknames maps common short first names to the name they stand for
+var firstNameNicknames = map[string]string{
+	"alex": "alexander", "ben": "benjamin", "bill": "william", "bob": "robert",
+	"cam": "cameron", "chris": "christopher", "dan": "daniel", "danny": "daniel",
+	"dave": "david", "gabe": "gabriel", "jim": "james", "jimmy": "james",
+	"joe": "joseph", "jon": "jonathan", "josh": "joshua", "ken": "kenneth",
+	"matt": "matthew", "mike": "michael", "nate": "nathaniel", "nick": "nicholas",
+	"pat": "patrick", "rob": "robert", "sam": "samuel", "steve": "steven",
+	"tom": "thomas", "tony": "anthony", "will": "william", "zach": "zachary",
+}
+
+// playerNicknames maps well-known player nicknames to their names
+var playerNicknames = map[string]string{
+	"arsb": "amon-ra st. brown",
+	"cmc":  "christian mccaffrey",
+	"jsn":  "jaxon smith-njigba",
+	"mhj":  "marvin harrison",
+	"obj":  "odell beckham",
+	"kw3":  "kenneth walker",
+}
+
+// Match types for player name searches, strongest first
+const (
+	MatchTypeExact   = "exact"
+	MatchTypeName    = "name"
+	MatchTypePrefix  = "prefix"
+	MatchTypePartial = "partial"
+	MatchTypeFuzzy   = "fuzzy"
+)
+
+// PlayerOwnership represents the fantasy team that rosters a player in a league
+type PlayerOwnership struct {
+	RosterID    int    `json:"roster_id"`
+	OwnerID     string `json:"owner_id"`
+	DisplayName string `json:"display_name"`
+	TeamName    string `json:"team_name,omitempty"`
+	Slot        string `json:"slot"` // "starter", "bench", "reserve" or "taxi"
+}
+
+// PlayerSearchResult represents one player matching a search
+type PlayerSearchResult struct {
+	PlayerID         string           `json:"player_id"`
+	Name             string           `json:"name"`
+	Position         string           `json:"position"`
+	FantasyPositions []string         `json:"fantasy_positions,omitempty"`
+	Team             string           `json:"team"` // "FA" when unsigned
+	Status           string           `json:"status,omitempty"`
+	InjuryStatus     string           `json:"injury_status,omitempty"`
+	Age              int              `json:"age,omitempty"`
+	YearsExp         int              `json:"years_exp"`
+	MatchType        string           `json:"match_type,omitempty"`
+	MatchScore       int              `json:"match_score,omitempty"`
+	RosteredBy       *PlayerOwnership `json:"rostered_by,omitempty"`
+	FreeAgent        bool             `json:"free_agent,omitempty"` // Only set when a league was given
+}
+
+// PlayerSearchResults represents a page of player search results
+type PlayerSearchResults struct {
+	Query        string               `json:"query,omitempty"`
+	TotalMatches int                  `json:"total_matches"`
+	Offset       int                  `json:"offset"`
+	Limit        int                  `json:"limit"`
+	HasMore      bool                 `json:"has_more"`
+	Results      []PlayerSearchResult `json:"results"`
+}
+
+// playerFilters narrows player searches by position, team and status
+type playerFilters struct {
+	Position     string
+	Team         string // NFL team abbreviation, or "FA" for unsigned players
+	Status       string
+	InjuryStatus string // "healthy" matches players without an injury designation
+}
+
+// TrendingPlayerResult represents a player being added or dropped across Sleeper
+type TrendingPlayerResult struct {
+	Rank         int              `json:"rank"`
+	PlayerID     string           `json:"player_id"`
+	Name         string           `json:"name"`
+	Position     string           `json:"position"`
+	Team         string           `json:"team"` // "FA" when unsigned
+	Status       string           `json:"status,omitempty"`
+	InjuryStatus string           `json:"injury_status,omitempty"`
+	Count        int              `json:"count"` // Adds or drops across Sleeper in the lookback window
+	RosteredBy   *PlayerOwnership `json:"rostered_by,omitempty"`
+	Available    bool             `json:"available,omitempty"` // Only set when a league was given
+}
+
+// TrendingPlayers represents the most added or dropped players
+type TrendingPlayers struct {
+	Type          string                 `json:"type"` // "add" or "drop"
+	LookbackHours int                    `json:"lookback_hours"`
+	Position      string                 `json:"position,omitempty"`
+	Players       []TrendingPlayerResult `json:"players"`
+}
+
+// PlayerHandler handles player-related MCP tools
+type PlayerHandler struct {
+	client sleeper.Client
+	logger *logrus.Logger
+}
+
+// NewPlayerHandler creates a new player handler
+func NewPlayerHandler(client sleeper.Client, logger *logrus.Logger) *PlayerHandler {
+	return &PlayerHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// SearchPlayersTool returns the MCP tool definition for search_players
+func (h *PlayerHandler) SearchPlayersTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "search_players",
+		Description: "Search NFL players by name with fuzzy matching (nicknames, punctuation like D'Andre and suffixes like Jr. are handled), filtered by position, NFL team, status or injury status. Pass a league_id to see which fantasy team rosters each player.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"query": map[string]interface{}{
+					"type":        "string",
+					"description": "Player name or nickname to search for (optional when filtering)",
+					"required":    false,
+				},
+				"position": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players eligible at this position (e.g. QB, RB, WR, TE, K, DEF)",
+					"required":    false,
+				},
+				"team": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players on this NFL team (e.g. KC), or FA for unsigned players",
+					"required":    false,
+				},
+				"status": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players with this status (e.g. Active, Inactive, Injured Reserve)",
+					"required":    false,
+				},
+				"injury_status": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players with this injury status (e.g. Questionable, Out, IR), or healthy",
+					"required":    false,
+				},
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Show which team in this league rosters each player",
+					"required":    false,
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum results to return (default: 10, max: 50)",
+					"required":    false,
+				},
+				"offset": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of results to skip, for paging (default: 0)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleSearchPlayers handles the search_players tool call
+func (h *PlayerHandler) HandleSearchPlayers(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling search_players")
+
+	// Parse arguments
+	query, _ := args["query"].(string)
+	filters := playerFilters{}
+	filters.Position, _ = args["position"].(string)
+	filters.Team, _ = args["team"].(string)
+	filters.Status, _ = args["status"].(string)
+	filters.InjuryStatus, _ = args["injury_status"].(string)
+	leagueID, _ := args["league_id"].(string)
+	if strings.TrimSpace(query) == "" && filters == (playerFilters{}) {
+		return nil, fmt.Errorf("query or at least one filter is required")
+	}
+
+	limit := 10
+	if limitFloat, ok := args["limit"].(float64); ok && limitFloat > 0 {
+		limit = int(math.Min(limitFloat, 50))
+	}
+	offset := 0
+	if offsetFloat, ok := args["offset"].(float64); ok && offsetFloat > 0 {
+		offset = int(offsetFloat)
+	}
+
+	players, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+	apiCalls := 1
+
+	var ownership map[string]PlayerOwnership
+	if leagueID != "" {
+		var calls int
+		ownership, calls, err = h.leagueOwnership(leagueID)
+		apiCalls += calls
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to get league rosters")
+			return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+		}
+	}
+
+	matches := searchPlayers(players, query, filters)
+	results := &PlayerSearchResults{
+		Query:        query,
+		TotalMatches: len(matches),
+		Offset:       offset,
+		Limit:        limit,
+	}
+	if offset < len(matches) {
+		end := offset + limit
+		if end > len(matches) {
+			end = len(matches)
+		}
+		results.Results = matches[offset:end]
+		results.HasMore = end < len(matches)
+	}
+	if ownership != nil {
+		for i := range results.Results {
+			if owner, rostered := ownership[results.Results[i].PlayerID]; rostered {
+				results.Results[i].RosteredBy = &owner
+			} else {
+				results.Results[i].FreeAgent = true
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("Found %d players", results.TotalMatches)
+	if query != "" {
+		summary = fmt.Sprintf("Found %d players matching %q", results.TotalMatches, query)
+	}
+	if len(results.Results) > 0 {
+		summary += fmt.Sprintf(", showing %d-%d", offset+1, offset+len(results.Results))
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    results,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// GetTrendingPlayersTool returns the MCP tool definition for get_trending_players
+func (h *PlayerHandler) GetTrendingPlayersTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_trending_players",
+		Description: "Get the players most added or dropped across Sleeper for waiver wire insights, with names, positions, teams and injury status. Pass a league_id to see whether each player is rostered in your league and by whom.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"type": map[string]interface{}{
+					"type":        "string",
+					"description": "Type of trend to retrieve: add or drop",
+					"required":    true,
+				},
+				"hours": map[string]interface{}{
+					"type":        "integer",
+					"description": "Lookback period in hours (default: 24)",
+					"required":    false,
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Number of players to return (default: 25, max: 100)",
+					"required":    false,
+				},
+				"position": map[string]interface{}{
+					"type":        "string",
+					"description": "Only players eligible at this position (e.g. RB)",
+					"required":    false,
+				},
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Mark each player as rostered or available in this league",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetTrendingPlayers handles the get_trending_players tool call
+func (h *PlayerHandler) HandleGetTrendingPlayers(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_trending_players")
+
+	// Parse arguments
+	trendType, ok := args["type"].(string)
+	trendType = strings.ToLower(trendType)
+	if !ok || (trendType != "add" && trendType != "drop") {
+		return nil, fmt.Errorf("type is required and must be add or drop")
+	}
+
+	hours := 24
+	if hoursFloat, ok := args["hours"].(float64); ok && hoursFloat > 0 {
+		hours = int(hoursFloat)
+	}
+	limit := 25
+	if limitFloat, ok := args["limit"].(float64); ok && limitFloat > 0 {
+		limit = int(math.Min(limitFloat, 100))
+	}
+	position, _ := args["position"].(string)
+	leagueID, _ := args["league_id"].(string)
+
+	// Sleeper can't filter by position, so fetch a deeper list to filter ourselves
+	fetchLimit := limit
+	if position != "" {
+		fetchLimit = 200
+	}
+	trending, err := h.client.GetTrendingPlayers("nfl", trendType, hours, fetchLimit)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get trending players")
+		return toolErrorResult(fmt.Sprintf("Failed to get trending players: %s", err.Error())), nil
+	}
+	apiCalls := 1
+
+	players, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get players, continuing without player details")
+	}
+	apiCalls++
+
+	var ownership map[string]PlayerOwnership
+	if leagueID != "" {
+		var calls int
+		ownership, calls, err = h.leagueOwnership(leagueID)
+		apiCalls += calls
+		if err != nil {
+			h.logger.WithError(err).Error("Failed to get league rosters")
+			return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+		}
+	}
+
+	result := &TrendingPlayers{
+		Type:          trendType,
+		LookbackHours: hours,
+		Position:      position,
+		Players:       []TrendingPlayerResult{},
+	}
+	for _, trend := range trending {
+		if len(result.Players) >= limit {
+			break
+		}
+
+		player, known := players[trend.PlayerID]
+		if position != "" && !(playerFilters{Position: position}).matches(player) {
+			continue
+		}
+
+		entry := TrendingPlayerResult{
+			Rank:     len(result.Players) + 1,
+			PlayerID: trend.PlayerID,
+			Name:     trend.PlayerID,
+			Team:     "FA",
+			Count:    trend.Count,
+		}
+		if known {
+			entry.Name = playerName(player)
+			entry.Position = player.Position
+			entry.Status = player.Status
+			entry.InjuryStatus = player.InjuryStatus
+			if player.Team != "" {
+				entry.Team = player.Team
+			}
+		}
+		if ownership != nil {
+			if owner, rostered := ownership[trend.PlayerID]; rostered {
+				entry.RosteredBy = &owner
+			} else {
+				entry.Available = true
+			}
+		}
+		result.Players = append(result.Players, entry)
+	}
+
+	verb := "added"
+	if trendType == "drop" {
+		verb = "dropped"
+	}
+	summary := fmt.Sprintf("Top %d most %s players over the last %d hours", len(result.Players), verb, hours)
+	if len(result.Players) > 0 {
+		summary += fmt.Sprintf(", led by %s (%d)", result.Players[0].Name, result.Players[0].Count)
+	}
+	if ownership != nil {
+		available := 0
+		for _, entry := range result.Players {
+			if entry.Available {
+				available++
+			}
+		}
+		summary += fmt.Sprintf("; %d available in your league", available)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    result,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// leagueOwnership maps every rostered player in a league to the team that holds them
+func (h *PlayerHandler) leagueOwnership(leagueID string) (map[string]PlayerOwnership, int, error) {
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		return nil, 1, err
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without display names")
+	}
+	usersByID := make(map[string]sleeper.User)
+	for _, user := range users {
+		usersByID[user.UserID] = user
+	}
+
+	ownership := make(map[string]PlayerOwnership)
+	for _, roster := range rosters {
+		owner := PlayerOwnership{RosterID: roster.RosterID, OwnerID: roster.OwnerID}
+		if user, exists := usersByID[roster.OwnerID]; exists {
+			owner.DisplayName = userDisplayName(user)
+			owner.TeamName = user.Metadata.TeamName
+		}
+
+		slots := map[string]string{}
+		for _, playerID := range roster.Players {
+			slots[playerID] = "bench"
+		}
+		for _, playerID := range roster.Starters {
+			slots[playerID] = "starter"
+		}
+		for _, playerID := range roster.Reserve {
+			slots[playerID] = "reserve"
+		}
+		for _, playerID := range roster.Taxi {
+			slots[playerID] = "taxi"
+		}
+		for playerID, slot := range slots {
+			if playerID == "" || playerID == "0" {
+				continue
+			}
+			owner.Slot = slot
+			ownership[playerID] = owner
+		}
+	}
+
+	return ownership, 2, nil
+}
+
+// searchPlayers returns every player passing the filters and matching the query,
+// best match first. Equal matches are ordered by Sleeper's search rank.
+func searchPlayers(players map[string]sleeper.Player, query string, filters playerFilters) []PlayerSearchResult {
+	queryTokens := normalizeNameTokens(query)
+	if nickname, exists := playerNicknames[strings.Join(queryTokens, "")]; exists {
+		queryTokens = normalizeNameTokens(nickname)
+	}
+
+	type match struct {
+		player    sleeper.Player
+		score     int
+		matchType string
+	}
+	var matches []match
+	for playerID, player := range players {
+		if !filters.matches(player) {
+			continue
+		}
+		if player.PlayerID == "" {
+			player.PlayerID = playerID
+		}
+
+		score, matchType := 0, ""
+		if len(queryTokens) > 0 {
+			score, matchType = matchPlayerName(queryTokens, normalizeNameTokens(playerName(player)))
+			if score == 0 {
+				continue
+			}
+		}
+		matches = append(matches, match{player: player, score: score, matchType: matchType})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		rankA, rankB := a.player.SearchRank, b.player.SearchRank
+		if rankA <= 0 {
+			rankA = math.MaxInt32
+		}
+		if rankB <= 0 {
+			rankB = math.MaxInt32
+		}
+		if rankA != rankB {
+			return rankA < rankB
+		}
+		return a.player.PlayerID < b.player.PlayerID
+	})
+
+	results := make([]PlayerSearchResult, 0, len(matches))
+	for _, m := range matches {
+		team := m.player.Team
+		if team == "" {
+			team = "FA"
+		}
+		results = append(results, PlayerSearchResult{
+			PlayerID:         m.player.PlayerID,
+			Name:             playerName(m.player),
+			Position:         m.player.Position,
+			FantasyPositions: m.player.FantasyPositions,
+			Team:             team,
+			Status:           m.player.Status,
+			InjuryStatus:     m.player.InjuryStatus,
+			Age:              m.player.Age,
+			YearsExp:         m.player.YearsExp,
+			MatchType:        m.matchType,
+			MatchScore:       m.score,
+		})
+	}
+	return results
+}
+
+// matches checks a player against every filter that is set
+func (f playerFilters) matches(player sleeper.Player) bool {
+	if f.Position != "" && !strings.EqualFold(player.Position, f.Position) {
+		eligible := false
+		for _, position := range playerPositions(player) {
+			if strings.EqualFold(position, f.Position) {
+				eligible = true
+				break
+			}
+		}
+		if !eligible {
+			return false
+		}
+	}
+	if f.Team != "" {
+		team := player.Team
+		if team == "" {
+			team = "FA"
+		}
+		if !strings.EqualFold(team, f.Team) {
+			return false
+		}
+	}
+	if f.Status != "" && !strings.EqualFold(player.Status, f.Status) {
+		return false
+	}
+	if f.InjuryStatus != "" {
+		if strings.EqualFold(f.InjuryStatus, "healthy") {
+			return player.InjuryStatus == ""
+		}
+		if !strings.EqualFold(player.InjuryStatus, f.InjuryStatus) {
+			return false
+		}
+	}
+	return true
+}
+
+// playerName returns a player's full name, building it from first and last names
+// for entries like team defenses that have no full name
+func playerName(player sleeper.Player) string {
+	if player.FullName != "" {
+		return player.FullName
+	}
+	return strings.TrimSpace(player.FirstName + " " + player.LastName)
+}
+
+// normalizeNameTokens lowercases a name, drops apostrophes, periods and suffixes,
+// and splits it on anything else that isn't a letter or digit
+func normalizeNameTokens(name string) []string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '\'' || r == '’' || r == '.':
+			continue
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	var tokens []string
+	for _, token := range strings.Fields(builder.String()) {
+		if !nameSuffixes[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// matchPlayerName scores how well query tokens match a player's name tokens.
+// It returns 0 when the name does not match.
+func matchPlayerName(queryTokens, nameTokens []string) (int, string) {
+	if len(nameTokens) == 0 {
+		return 0, ""
+	}
+	query, name := strings.Join(queryTokens, ""), strings.Join(nameTokens, "")
+	if query == name {
+		return 100, MatchTypeExact
+	}
+
+	sameName := func(q, n string) bool {
+		return q == n || canonicalFirstName(q) == canonicalFirstName(n)
+	}
+	if allTokensMatch(queryTokens, nameTokens, sameName) {
+		return 90, MatchTypeName
+	}
+	if allTokensMatch(queryTokens, nameTokens, func(q, n string) bool {
+		return sameName(q, n) || (len(q) >= 2 && strings.HasPrefix(n, q))
+	}) {
+		return 75, MatchTypePrefix
+	}
+	if len(query) >= 3 && strings.Contains(name, query) {
+		return 60, MatchTypePartial
+	}
+
+	// Allow small typos in each query token
+	totalDistance := 0
+	for _, q := range queryTokens {
+		if len(q) < 3 {
+			return 0, ""
+		}
+		maxDistance := 1
+		if len(q) > 5 {
+			maxDistance = 2
+		}
+		best := maxDistance + 1
+		for _, n := range nameTokens {
+			if distance := levenshtein(q, n); distance < best {
+				best = distance
+			}
+		}
+		if best > maxDistance {
+			return 0, ""
+		}
+		totalDistance += best
+	}
+	return 50 - totalDistance*5, MatchTypeFuzzy
+}
+
+// allTokensMatch reports whether every query token matches a distinct name token
+func allTokensMatch(queryTokens, nameTokens []string, match func(q, n string) bool) bool {
+	used := make([]bool, len(nameTokens))
+	for _, q := range queryTokens {
+		found := false
+		for i, n := range nameTokens {
+			if !used[i] && match(q, n) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalFirstName maps a nickname like "mike" to the name it stands for
+func canonicalFirstName(name string) string {
+	if canonical, exists := firstNameNicknames[name]; exists {
+		return canonical
+	}
+	return name
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
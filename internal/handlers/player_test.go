@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// newSearchPlayersMockClient builds a two-team league and a small player pool, shared by
+// the search and trending players handler tests
+func newSearchPlayersMockClient() *MockSleeperClient {
+	return &MockSleeperClient{
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"1": {PlayerID: "1", FullName: "D'Andre Swift", FirstName: "D'Andre", LastName: "Swift", Position: "RB", Team: "CHI", Status: "Active", SearchRank: 40},
+				"2": {PlayerID: "2", FullName: "Marvin Harrison Jr.", FirstName: "Marvin", LastName: "Harrison", Position: "WR", Team: "ARI", Status: "Active", SearchRank: 20},
+				"3": {PlayerID: "3", FullName: "Mike Evans", FirstName: "Mike", LastName: "Evans", Position: "WR", Team: "TB", Status: "Active", InjuryStatus: "Questionable", SearchRank: 15},
+				"4": {PlayerID: "4", FullName: "Michael Pittman", FirstName: "Michael", LastName: "Pittman", Position: "WR", Team: "IND", Status: "Active", SearchRank: 60},
+				"5": {PlayerID: "5", FullName: "Christian McCaffrey", FirstName: "Christian", LastName: "McCaffrey", Position: "RB", Team: "SF", Status: "Active", SearchRank: 1},
+				"6": {PlayerID: "6", FullName: "Taysom Hill", FirstName: "Taysom", LastName: "Hill", Position: "TE", FantasyPositions: []string{"QB", "TE"}, Team: "NO", Status: "Active"},
+				"7": {PlayerID: "7", FullName: "Old Veteran", FirstName: "Old", LastName: "Veteran", Position: "WR", Status: "Inactive"},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, OwnerID: "user1", Players: []string{"1", "3"}, Starters: []string{"3"}},
+				{RosterID: 2, OwnerID: "user2", Players: []string{"5"}, Starters: []string{"5"}},
+			}, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{{UserID: "user1", DisplayName: "Sam"}, {UserID: "user2", DisplayName: "Alex"}}, nil
+		},
+	}
+}
+
+func TestSearchPlayers_NameMatching(t *testing.T) {
+	players := map[string]sleeper.Player{
+		"1": {PlayerID: "1", FullName: "D'Andre Swift", FirstName: "D'Andre", LastName: "Swift", Position: "RB", SearchRank: 40},
+		"2": {PlayerID: "2", FullName: "Marvin Harrison Jr.", FirstName: "Marvin", LastName: "Harrison", Position: "WR", SearchRank: 20},
+		"3": {PlayerID: "3", FullName: "Mike Evans", FirstName: "Mike", LastName: "Evans", Position: "WR", SearchRank: 15},
+		"4": {PlayerID: "4", FullName: "Michael Pittman", FirstName: "Michael", LastName: "Pittman", Position: "WR", SearchRank: 60},
+		"5": {PlayerID: "5", FullName: "Christian McCaffrey", FirstName: "Christian", LastName: "McCaffrey", Position: "RB", SearchRank: 1},
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"dandre swift", "1"},
+		{"D'Andre", "1"},
+		{"marvin harrison", "2"},
+		{"Marvin Harrison Jr", "2"},
+		{"michael evans", "3"},
+		{"cmc", "5"},
+		{"mccafrey", "5"},
+		{"mccaf", "5"},
+	}
+
+	for _, tt := range tests {
+		results := searchPlayers(players, tt.query, playerFilters{})
+		if len(results) == 0 || results[0].PlayerID != tt.expected {
+			t.Errorf("Expected %q to find player %s first, got %+v", tt.query, tt.expected, results)
+		}
+	}
+
+	if results := searchPlayers(players, "zzzz", playerFilters{}); len(results) != 0 {
+		t.Errorf("Expected no matches for an unrelated query, got %d", len(results))
+	}
+}
+
+func TestSearchPlayers_RanksEqualMatchesBySearchRank(t *testing.T) {
+	players := map[string]sleeper.Player{
+		"3": {PlayerID: "3", FullName: "Mike Evans", FirstName: "Mike", LastName: "Evans", Position: "WR", SearchRank: 15},
+		"4": {PlayerID: "4", FullName: "Mike Williams", FirstName: "Mike", LastName: "Williams", Position: "WR", SearchRank: 60},
+		"5": {PlayerID: "5", FullName: "Christian McCaffrey", FirstName: "Christian", LastName: "McCaffrey", Position: "RB", SearchRank: 1},
+	}
+
+	// Both Mike Evans and Mike Williams match "mike" by name
+	results := searchPlayers(players, "mike", playerFilters{})
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 matches, got %d", len(results))
+	}
+	if results[0].PlayerID != "3" || results[1].PlayerID != "4" {
+		t.Errorf("Expected Evans (rank 15) before Williams (rank 60), got %s then %s", results[0].Name, results[1].Name)
+	}
+}
+
+func TestSearchPlayers_Filters(t *testing.T) {
+	players := map[string]sleeper.Player{
+		"1": {PlayerID: "1", FullName: "CeeDee Lamb", Position: "WR", Team: "DAL", Status: "Active"},
+		"2": {PlayerID: "2", FullName: "Rashee Rice", Position: "WR", Team: "KC", Status: "Active", InjuryStatus: "Questionable"},
+		"3": {PlayerID: "3", FullName: "Taysom Hill", Position: "TE", FantasyPositions: []string{"QB", "TE"}, Team: "NO", Status: "Active"},
+		"4": {PlayerID: "4", FullName: "Christian McCaffrey", Position: "RB", Team: "SF", Status: "Active"},
+		"5": {PlayerID: "5", FullName: "Old Veteran", Position: "WR", Status: "Inactive"},
+	}
+
+	tests := []struct {
+		name     string
+		filters  playerFilters
+		expected int
+	}{
+		{"position", playerFilters{Position: "wr"}, 3},
+		{"fantasy position eligibility", playerFilters{Position: "QB"}, 1},
+		{"team", playerFilters{Team: "sf"}, 1},
+		{"free agents", playerFilters{Team: "FA"}, 1},
+		{"status", playerFilters{Status: "inactive"}, 1},
+		{"injury status", playerFilters{InjuryStatus: "questionable"}, 1},
+		{"healthy", playerFilters{Position: "WR", InjuryStatus: "healthy"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := searchPlayers(players, "", tt.filters)
+			if len(results) != tt.expected {
+				t.Errorf("Expected %d players, got %d", tt.expected, len(results))
+			}
+		})
+	}
+}
+
+func TestPlayerHandler_SearchPlayers(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewPlayerHandler(newSearchPlayersMockClient(), logger)
+
+	result, err := handler.HandleSearchPlayers(context.Background(), map[string]interface{}{
+		"position":  "WR",
+		"league_id": "league1",
+		"limit":     float64(2),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool                `json:"success"`
+		Data    PlayerSearchResults `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	results := response.Data
+	if results.TotalMatches != 4 || len(results.Results) != 2 || !results.HasMore {
+		t.Fatalf("Expected the first 2 of 4 receivers with more to come, got %+v", results)
+	}
+
+	evans := results.Results[0]
+	if evans.PlayerID != "3" || evans.RosteredBy == nil {
+		t.Fatalf("Expected Mike Evans to be rostered, got %+v", evans)
+	}
+	if evans.RosteredBy.DisplayName != "Sam" || evans.RosteredBy.Slot != "starter" {
+		t.Errorf("Expected Evans to start for Sam, got %+v", evans.RosteredBy)
+	}
+	if harrison := results.Results[1]; harrison.RosteredBy != nil || !harrison.FreeAgent {
+		t.Errorf("Expected Marvin Harrison to be a free agent, got %+v", harrison)
+	}
+}
+
+func TestPlayerHandler_SearchPlayers_RequiresQueryOrFilter(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewPlayerHandler(newSearchPlayersMockClient(), logger)
+
+	if _, err := handler.HandleSearchPlayers(context.Background(), map[string]interface{}{}); err == nil {
+		t.Error("Expected an error without a query or filter")
+	}
+}
+
+func TestPlayerHandler_GetTrendingPlayers(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	mockClient := newSearchPlayersMockClient()
+	var requestedLimit int
+	mockClient.GetTrendingPlayersFunc = func(sport, trendType string, hours, limit int) ([]sleeper.TrendingPlayer, error) {
+		requestedLimit = limit
+		return []sleeper.TrendingPlayer{
+			{PlayerID: "5", Count: 900},
+			{PlayerID: "2", Count: 700},
+			{PlayerID: "1", Count: 500},
+			{PlayerID: "3", Count: 300},
+		}, nil
+	}
+	handler := NewPlayerHandler(mockClient, logger)
+
+	result, err := handler.HandleGetTrendingPlayers(context.Background(), map[string]interface{}{
+		"type":      "add",
+		"position":  "RB",
+		"league_id": "league1",
+		"limit":     float64(5),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requestedLimit <= 5 {
+		t.Errorf("Expected a deeper list to be fetched when filtering by position, got limit %d", requestedLimit)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool            `json:"success"`
+		Data    TrendingPlayers `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	players := response.Data.Players
+	if len(players) != 2 {
+		t.Fatalf("Expected 2 trending running backs, got %d", len(players))
+	}
+
+	mccaffrey, swift := players[0], players[1]
+	if mccaffrey.Name != "Christian McCaffrey" || mccaffrey.Rank != 1 || mccaffrey.Count != 900 {
+		t.Errorf("Expected McCaffrey ranked first with 900 adds, got %+v", mccaffrey)
+	}
+	if mccaffrey.RosteredBy == nil || mccaffrey.RosteredBy.DisplayName != "Alex" {
+		t.Errorf("Expected McCaffrey to be rostered by Alex, got %+v", mccaffrey.RosteredBy)
+	}
+	if swift.Rank != 2 || swift.RosteredBy == nil || swift.RosteredBy.Slot != "bench" {
+		t.Errorf("Expected Swift ranked second on Sam's bench, got %+v", swift)
+	}
+}
+
+func TestPlayerHandler_GetTrendingPlayers_MarksAvailablePlayers(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	mockClient := newSearchPlayersMockClient()
+	mockClient.GetTrendingPlayersFunc = func(sport, trendType string, hours, limit int) ([]sleeper.TrendingPlayer, error) {
+		if trendType != "drop" || hours != 48 {
+			t.Errorf("Expected drops over 48 hours, got %s over %d", trendType, hours)
+		}
+		return []sleeper.TrendingPlayer{{PlayerID: "4", Count: 120}}, nil
+	}
+	handler := NewPlayerHandler(mockClient, logger)
+
+	result, err := handler.HandleGetTrendingPlayers(context.Background(), map[string]interface{}{
+		"type":      "DROP",
+		"hours":     float64(48),
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var response struct {
+		Data TrendingPlayers `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(response.Data.Players) != 1 || !response.Data.Players[0].Available {
+		t.Errorf("Expected Michael Pittman to be available, got %+v", response.Data.Players)
+	}
+
+	if _, err := handler.HandleGetTrendingPlayers(context.Background(), map[string]interface{}{"type": "hold"}); err == nil {
+		t.Error("Expected an error for an unknown trend type")
+	}
+}
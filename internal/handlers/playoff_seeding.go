@@ -0,0 +1,337 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Seed types assigned to playoff teams
+const (
+	SeedTypeDivisionWinner    = "division_winner"
+	SeedTypeAtLarge           = "at_large"
+	SeedTypePointsForWildcard = "points_for_wildcard"
+)
+
+// PlayoffSeeding represents the computed playoff field for a league
+type PlayoffSeeding struct {
+	PlayoffTeams    int            `json:"playoff_teams"`
+	Byes            int            `json:"byes"`
+	Reseed          bool           `json:"reseed"`
+	Seeds           map[int]int    `json:"seeds"`      // RosterID -> seed
+	SeedTypes       map[int]string `json:"seed_types"` // RosterID -> seed type
+	DivisionWinners []int          `json:"division_winners,omitempty"`
+	Mismatches      []SeedMismatch `json:"mismatches,omitempty"`
+	Rounds          []PlayoffRound `json:"rounds,omitempty"`
+	Notes           []string       `json:"notes,omitempty"`
+}
+
+// SeedMismatch records a difference between our seed and the one Sleeper reports
+type SeedMismatch struct {
+	RosterID     int `json:"roster_id"`
+	ComputedSeed int `json:"computed_seed"`
+	SleeperSeed  int `json:"sleeper_seed"`
+}
+
+// PlayoffRound represents the seeded pairings of a single playoff round
+type PlayoffRound struct {
+	Round    int      `json:"round"`
+	Pairings [][2]int `json:"pairings"` // Seed pairs, better seed first
+	Byes     []int    `json:"byes,omitempty"`
+	Winners  []int    `json:"winners,omitempty"` // Seeds that advanced, when results are known
+}
+
+// computePlayoffSeeding assigns playoff seeds from sorted regular season standings
+// according to the league's seeding policy
+func computePlayoffSeeding(standings []StandingEntry, league *sleeper.League, policy config.PlayoffSeeding) *PlayoffSeeding {
+	playoffTeams := 6 // Default
+	if league != nil && league.Settings.PlayoffTeams > 0 {
+		playoffTeams = league.Settings.PlayoffTeams
+	}
+	if policy.PlayoffTeams > 0 {
+		playoffTeams = policy.PlayoffTeams
+	}
+	if playoffTeams > len(standings) {
+		playoffTeams = len(standings)
+	}
+
+	seeding := &PlayoffSeeding{
+		PlayoffTeams: playoffTeams,
+		Byes:         defaultPlayoffByes(playoffTeams),
+		Reseed:       policy.Reseed,
+		Seeds:        make(map[int]int),
+		SeedTypes:    make(map[int]string),
+	}
+	if policy.Byes != nil {
+		seeding.Byes = *policy.Byes
+	}
+
+	qualified := make(map[int]bool)
+
+	// Division winners are the best-ranked team in each division
+	var divisionWinners []StandingEntry
+	if policy.DivisionWinners != "" {
+		seenDivisions := make(map[int]bool)
+		for _, entry := range standings {
+			if entry.Division == 0 || seenDivisions[entry.Division] {
+				continue
+			}
+			seenDivisions[entry.Division] = true
+			divisionWinners = append(divisionWinners, entry)
+			seeding.DivisionWinners = append(seeding.DivisionWinners, entry.RosterID)
+		}
+		if len(divisionWinners) == 0 {
+			seeding.Notes = append(seeding.Notes, "Division winner seeding configured but no divisions found; seeding by record")
+		}
+		if len(divisionWinners) > playoffTeams {
+			divisionWinners = divisionWinners[:playoffTeams]
+		}
+		for _, entry := range divisionWinners {
+			qualified[entry.RosterID] = true
+			seeding.SeedTypes[entry.RosterID] = SeedTypeDivisionWinner
+		}
+	}
+
+	// Remaining spots go to the best records, except the points-for wildcards
+	pointsForWildcards := policy.PointsForWildcards
+	if pointsForWildcards > playoffTeams-len(qualified) {
+		pointsForWildcards = playoffTeams - len(qualified)
+	}
+	recordSpots := playoffTeams - len(qualified) - pointsForWildcards
+
+	var atLarge []StandingEntry
+	for _, entry := range standings {
+		if recordSpots == 0 {
+			break
+		}
+		if qualified[entry.RosterID] {
+			continue
+		}
+		qualified[entry.RosterID] = true
+		seeding.SeedTypes[entry.RosterID] = SeedTypeAtLarge
+		atLarge = append(atLarge, entry)
+		recordSpots--
+	}
+
+	var remaining []StandingEntry
+	for _, entry := range standings {
+		if !qualified[entry.RosterID] {
+			remaining = append(remaining, entry)
+		}
+	}
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].PointsFor > remaining[j].PointsFor
+	})
+	var wildcards []StandingEntry
+	for i := 0; i < pointsForWildcards && i < len(remaining); i++ {
+		qualified[remaining[i].RosterID] = true
+		seeding.SeedTypes[remaining[i].RosterID] = SeedTypePointsForWildcard
+		wildcards = append(wildcards, remaining[i])
+	}
+
+	// Order the field: top-seeded division winners first, then by record,
+	// with points-for wildcards taking the lowest seeds
+	var ordered []StandingEntry
+	if policy.DivisionWinners == "top_seeds" {
+		ordered = append(ordered, divisionWinners...)
+		ordered = append(ordered, atLarge...)
+	} else {
+		for _, entry := range standings {
+			if seeding.SeedTypes[entry.RosterID] == SeedTypeDivisionWinner || seeding.SeedTypes[entry.RosterID] == SeedTypeAtLarge {
+				ordered = append(ordered, entry)
+			}
+		}
+	}
+	ordered = append(ordered, wildcards...)
+
+	for i, entry := range ordered {
+		seed := i + 1
+		seeding.Seeds[entry.RosterID] = seed
+
+		if entry.PlayoffSeed != 0 && entry.PlayoffSeed != seed {
+			seeding.Mismatches = append(seeding.Mismatches, SeedMismatch{
+				RosterID:     entry.RosterID,
+				ComputedSeed: seed,
+				SleeperSeed:  entry.PlayoffSeed,
+			})
+		}
+	}
+
+	// Teams Sleeper seeded that we left out of the field
+	for _, entry := range standings {
+		if entry.PlayoffSeed != 0 && !qualified[entry.RosterID] {
+			seeding.Mismatches = append(seeding.Mismatches, SeedMismatch{
+				RosterID:    entry.RosterID,
+				SleeperSeed: entry.PlayoffSeed,
+			})
+		}
+	}
+
+	seeding.Rounds = append(seeding.Rounds, firstPlayoffRound(playoffTeams, seeding.Byes))
+
+	return seeding
+}
+
+// applySeedingToStandings records computed seeds on the standings entries,
+// keeping the seed Sleeper reported for comparison
+func applySeedingToStandings(standings []StandingEntry, seeding *PlayoffSeeding) {
+	mismatches := make(map[int]SeedMismatch)
+	for _, mismatch := range seeding.Mismatches {
+		mismatches[mismatch.RosterID] = mismatch
+	}
+
+	for i := range standings {
+		rosterID := standings[i].RosterID
+		standings[i].SleeperPlayoffSeed = standings[i].PlayoffSeed
+		standings[i].PlayoffSeed = seeding.Seeds[rosterID]
+		standings[i].SeedType = seeding.SeedTypes[rosterID]
+		standings[i].PlayoffBye = standings[i].PlayoffSeed > 0 && standings[i].PlayoffSeed <= seeding.Byes
+
+		if mismatch, exists := mismatches[rosterID]; exists {
+			standings[i].SeedingNotes = fmt.Sprintf("Computed seed %d differs from Sleeper playoff_seed %d", mismatch.ComputedSeed, mismatch.SleeperSeed)
+		}
+	}
+}
+
+// defaultPlayoffByes returns the byes needed to fill a bracket to the next power of two
+func defaultPlayoffByes(playoffTeams int) int {
+	size := 1
+	for size < playoffTeams {
+		size *= 2
+	}
+	return size - playoffTeams
+}
+
+// firstPlayoffRound pairs the non-bye seeds best vs worst
+func firstPlayoffRound(playoffTeams, byes int) PlayoffRound {
+	round := PlayoffRound{Round: 1}
+	var playing []int
+	for seed := 1; seed <= playoffTeams; seed++ {
+		if seed <= byes {
+			round.Byes = append(round.Byes, seed)
+		} else {
+			playing = append(playing, seed)
+		}
+	}
+	round.Pairings = pairBestVsWorst(playing)
+	return round
+}
+
+// nextPlayoffRound pairs the seeds still alive after a round. With reseeding the
+// best remaining seed plays the worst; otherwise the fixed bracket is followed.
+func nextPlayoffRound(previous PlayoffRound, playoffTeams int, reseed bool) PlayoffRound {
+	alive := append(append([]int{}, previous.Byes...), previous.Winners...)
+	round := PlayoffRound{Round: previous.Round + 1}
+
+	if reseed {
+		sort.Ints(alive)
+		round.Pairings = pairBestVsWorst(alive)
+		return round
+	}
+
+	// Fixed bracket: walk the standard bracket order and pair neighbours that are still alive
+	aliveSet := make(map[int]bool)
+	for _, seed := range alive {
+		aliveSet[seed] = true
+	}
+	slots := bracketSlotOrder(playoffTeams)
+	groupSize := 1 << uint(round.Round)
+	for start := 0; start < len(slots); start += groupSize {
+		var pair []int
+		for _, seed := range slots[start:min(start+groupSize, len(slots))] {
+			if aliveSet[seed] {
+				pair = append(pair, seed)
+			}
+		}
+		if len(pair) == 2 {
+			sort.Ints(pair)
+			round.Pairings = append(round.Pairings, [2]int{pair[0], pair[1]})
+		} else if len(pair) == 1 {
+			round.Byes = append(round.Byes, pair[0])
+		}
+	}
+	return round
+}
+
+// pairBestVsWorst pairs a sorted list of seeds from the outside in
+func pairBestVsWorst(seeds []int) [][2]int {
+	var pairings [][2]int
+	for i, j := 0, len(seeds)-1; i < j; i, j = i+1, j-1 {
+		pairings = append(pairings, [2]int{seeds[i], seeds[j]})
+	}
+	return pairings
+}
+
+// bracketSlotOrder returns the standard bracket order (1, 8, 4, 5, 2, 7, 3, 6 for eight slots)
+func bracketSlotOrder(playoffTeams int) []int {
+	slots := []int{1}
+	for len(slots) < playoffTeams {
+		size := len(slots) * 2
+		next := make([]int, 0, size)
+		for _, seed := range slots {
+			next = append(next, seed, size+1-seed)
+		}
+		slots = next
+	}
+	return slots
+}
+
+// trackSeededRounds replays the winners bracket against the seeded pairings,
+// noting where Sleeper's bracket departs from the configured policy
+func trackSeededRounds(seeding *PlayoffSeeding, winnersBracket []sleeper.BracketMatchup) {
+	if len(seeding.Rounds) == 0 {
+		return
+	}
+
+	rosterBySeed := make(map[int]int)
+	for rosterID, seed := range seeding.Seeds {
+		rosterBySeed[seed] = rosterID
+	}
+
+	fixedBracket := seeding.Byes == defaultPlayoffByes(seeding.PlayoffTeams)
+	if !fixedBracket && !seeding.Reseed {
+		seeding.Notes = append(seeding.Notes, fmt.Sprintf("%d byes do not fill a standard bracket; later rounds are paired best vs worst", seeding.Byes))
+	}
+
+	for i := 0; i < len(seeding.Rounds); i++ {
+		round := &seeding.Rounds[i]
+		complete := true
+
+		for _, pairing := range round.Pairings {
+			team1, team2 := rosterBySeed[pairing[0]], rosterBySeed[pairing[1]]
+			game := findBracketGame(winnersBracket, round.Round, team1, team2)
+			if game == nil {
+				seeding.Notes = append(seeding.Notes, fmt.Sprintf("Round %d: seeds %d and %d are not paired in Sleeper's bracket", round.Round, pairing[0], pairing[1]))
+				complete = false
+				continue
+			}
+			if game.Winner == 0 {
+				complete = false
+				continue
+			}
+			round.Winners = append(round.Winners, seeding.Seeds[game.Winner])
+		}
+
+		if !complete || len(round.Winners)+len(round.Byes) < 2 {
+			return
+		}
+
+		sort.Ints(round.Winners)
+		seeding.Rounds = append(seeding.Rounds, nextPlayoffRound(*round, seeding.PlayoffTeams, seeding.Reseed || !fixedBracket))
+	}
+}
+
+// findBracketGame finds the winners bracket game between two rosters in a round
+func findBracketGame(winnersBracket []sleeper.BracketMatchup, round, team1, team2 int) *sleeper.BracketMatchup {
+	for i, game := range winnersBracket {
+		if game.Round != round {
+			continue
+		}
+		if (game.Team1 == team1 && game.Team2 == team2) || (game.Team1 == team2 && game.Team2 == team1) {
+			return &winnersBracket[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+func TestComputePlayoffSeeding(t *testing.T) {
+	// Standings already sorted by record
+	standings := []StandingEntry{
+		{RosterID: 1, Division: 1, PointsFor: 1500},
+		{RosterID: 2, Division: 1, PointsFor: 1450},
+		{RosterID: 3, Division: 1, PointsFor: 1300},
+		{RosterID: 4, Division: 2, PointsFor: 1200, PlayoffSeed: 2},
+		{RosterID: 5, Division: 2, PointsFor: 1600},
+		{RosterID: 6, Division: 2, PointsFor: 1100},
+	}
+	league := &sleeper.League{Settings: sleeper.LeagueSettings{PlayoffTeams: 4}}
+
+	tests := []struct {
+		name      string
+		policy    config.PlayoffSeeding
+		wantSeeds map[int]int
+		wantByes  int
+	}{
+		{
+			name:      "top four by record",
+			policy:    config.PlayoffSeeding{},
+			wantSeeds: map[int]int{1: 1, 2: 2, 3: 3, 4: 4},
+			wantByes:  0,
+		},
+		{
+			name:      "division winners take top seeds",
+			policy:    config.PlayoffSeeding{DivisionWinners: "top_seeds"},
+			wantSeeds: map[int]int{1: 1, 4: 2, 2: 3, 3: 4},
+			wantByes:  0,
+		},
+		{
+			name:      "points for wildcard takes the last seed",
+			policy:    config.PlayoffSeeding{DivisionWinners: "guaranteed", PointsForWildcards: 1},
+			wantSeeds: map[int]int{1: 1, 2: 2, 4: 3, 5: 4},
+			wantByes:  0,
+		},
+		{
+			name:      "six team field gets two byes",
+			policy:    config.PlayoffSeeding{PlayoffTeams: 6},
+			wantSeeds: map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6},
+			wantByes:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seeding := computePlayoffSeeding(standings, league, tt.policy)
+
+			if len(seeding.Seeds) != len(tt.wantSeeds) {
+				t.Fatalf("Expected %d seeded teams, got %d (%v)", len(tt.wantSeeds), len(seeding.Seeds), seeding.Seeds)
+			}
+			for rosterID, wantSeed := range tt.wantSeeds {
+				if seeding.Seeds[rosterID] != wantSeed {
+					t.Errorf("Expected roster %d to be seed %d, got %d", rosterID, wantSeed, seeding.Seeds[rosterID])
+				}
+			}
+			if seeding.Byes != tt.wantByes {
+				t.Errorf("Expected %d byes, got %d", tt.wantByes, seeding.Byes)
+			}
+		})
+	}
+}
+
+func TestComputePlayoffSeeding_SleeperMismatch(t *testing.T) {
+	standings := []StandingEntry{
+		{RosterID: 1, PlayoffSeed: 1},
+		{RosterID: 2, PlayoffSeed: 3},
+		{RosterID: 3, PlayoffSeed: 2},
+	}
+	league := &sleeper.League{Settings: sleeper.LeagueSettings{PlayoffTeams: 2}}
+
+	seeding := computePlayoffSeeding(standings, league, config.PlayoffSeeding{})
+
+	// Roster 2 disagrees on seed and roster 3 was seeded by Sleeper but not by us
+	if len(seeding.Mismatches) != 2 {
+		t.Fatalf("Expected 2 mismatches, got %d (%v)", len(seeding.Mismatches), seeding.Mismatches)
+	}
+
+	applySeedingToStandings(standings, seeding)
+	if standings[1].SleeperPlayoffSeed != 3 || standings[1].PlayoffSeed != 2 {
+		t.Errorf("Expected roster 2 seeded 2 (Sleeper 3), got %d (Sleeper %d)", standings[1].PlayoffSeed, standings[1].SleeperPlayoffSeed)
+	}
+	if standings[1].SeedingNotes == "" {
+		t.Error("Expected seeding notes for mismatched roster")
+	}
+}
+
+func TestNextPlayoffRound(t *testing.T) {
+	first := firstPlayoffRound(6, 2)
+	if len(first.Pairings) != 2 || first.Pairings[0] != [2]int{3, 6} || first.Pairings[1] != [2]int{4, 5} {
+		t.Fatalf("Expected first round 3v6 and 4v5, got %v", first.Pairings)
+	}
+
+	// The 6 seed and 4 seed advance
+	first.Winners = []int{4, 6}
+
+	fixed := nextPlayoffRound(first, 6, false)
+	if len(fixed.Pairings) != 2 || fixed.Pairings[0] != [2]int{1, 4} || fixed.Pairings[1] != [2]int{2, 6} {
+		t.Errorf("Expected fixed bracket 1v4 and 2v6, got %v", fixed.Pairings)
+	}
+
+	reseeded := nextPlayoffRound(first, 6, true)
+	if len(reseeded.Pairings) != 2 || reseeded.Pairings[0] != [2]int{1, 6} || reseeded.Pairings[1] != [2]int{2, 4} {
+		t.Errorf("Expected reseeded bracket 1v6 and 2v4, got %v", reseeded.Pairings)
+	}
+}
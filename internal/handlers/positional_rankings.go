@@ -0,0 +1,340 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Position tiers on the heat map
+const (
+	PositionTierSurplus = "surplus" // Depth worth trading from
+	PositionTierNeed    = "need"    // Starters worth trading for
+)
+
+// PositionRanking represents one team's standing at a position group
+type PositionRanking struct {
+	Position    string  `json:"position"`
+	Value       float64 `json:"value"` // Points from starters and depth in production mode, player count in depth mode
+	Rank        int     `json:"rank"`  // 1 is the league's best
+	StarterRank int     `json:"starter_rank,omitempty"`
+	DepthRank   int     `json:"depth_rank,omitempty"`
+	Strength    string  `json:"strength"` // Headcount rating against the league's lineup
+	Tier        string  `json:"tier,omitempty"`
+}
+
+// TeamPositionalRanking represents one team's ranks at every position and overall
+type TeamPositionalRanking struct {
+	RosterID     int               `json:"roster_id"`
+	OwnerID      string            `json:"owner_id"`
+	DisplayName  string            `json:"display_name"`
+	OverallRank  int               `json:"overall_rank"`
+	OverallScore float64           `json:"overall_score"` // Average positional rank weighted by starting slots; lower is better
+	Positions    []PositionRanking `json:"positions"`
+	Surpluses    []string          `json:"surpluses,omitempty"`
+	Needs        []string          `json:"needs,omitempty"`
+}
+
+// TradePartner represents two teams whose surpluses cover each other's needs
+type TradePartner struct {
+	RosterIDs   [2]int    `json:"roster_ids"`
+	Teams       [2]string `json:"teams"`
+	FirstGives  []string  `json:"first_gives"`  // Positions the first team can spare that the second needs
+	SecondGives []string  `json:"second_gives"` // Positions the second team can spare that the first needs
+	Description string    `json:"description"`
+}
+
+// PositionalRankings represents every roster ranked at every position in a league
+type PositionalRankings struct {
+	LeagueID        string   `json:"league_id"`
+	Mode            string   `json:"mode"`
+	ProductionWeeks []int    `json:"production_weeks,omitempty"`
+	Positions       []string `json:"positions"`
+	// HeatMap[i][j] is team i's rank at position j; rows follow Teams, columns follow Positions
+	Teams         []string                `json:"teams"`
+	HeatMap       [][]int                 `json:"heat_map"`
+	Rankings      []TeamPositionalRanking `json:"rankings"` // Best overall first
+	TradePartners []TradePartner          `json:"trade_partners,omitempty"`
+}
+
+// GetPositionalRankingsTool returns the MCP tool definition for get_positional_rankings
+func (h *RosterHandler) GetPositionalRankingsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_positional_rankings",
+		Description: "Rank every roster in a league at each position group and overall, returning a positional heat map with each team's surpluses and needs and the trade partners whose surpluses complement each other",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"mode": map[string]interface{}{
+					"type":        "string",
+					"description": "production (default once the season has scored weeks) ranks by fantasy points scored; depth ranks by headcount against the lineup",
+					"required":    false,
+				},
+				"weeks": map[string]interface{}{
+					"type":        "integer",
+					"description": "In production mode, only count the last N scored weeks (default: whole season)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetPositionalRankings handles the get_positional_rankings tool call
+func (h *RosterHandler) HandleGetPositionalRankings(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_positional_rankings")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	mode, _ := args["mode"].(string)
+	if mode != "" && mode != StrengthModeDepth && mode != StrengthModeProduction {
+		return nil, fmt.Errorf("mode must be %s or %s", StrengthModeDepth, StrengthModeProduction)
+	}
+	weeks := 0
+	if weeksFloat, ok := args["weeks"].(float64); ok && weeksFloat > 0 {
+		weeks = int(weeksFloat)
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without display names")
+	}
+
+	allPlayers, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+	apiCalls := 4
+
+	// Production needs scored weeks; before then only headcounts are meaningful
+	if mode == "" {
+		mode = StrengthModeProduction
+		if league.Settings.LastScoredLeg == 0 {
+			mode = StrengthModeDepth
+		}
+	}
+
+	var production map[int]map[string]PositionalProduction
+	var productionWeeks []int
+	if mode == StrengthModeProduction {
+		var weeklyMatchups map[int][]sleeper.Matchup
+		productionWeeks, weeklyMatchups = h.loadProductionMatchups(leagueID, league, weeks)
+		apiCalls += len(productionWeeks)
+		production = rankRosterProduction(rosters, allPlayers, league.RosterPositions, leaguePlayerPoints(weeklyMatchups))
+	}
+
+	rankings := buildPositionalRankings(rosters, users, allPlayers, league.RosterPositions, production)
+	rankings.LeagueID = leagueID
+	rankings.Mode = mode
+	rankings.ProductionWeeks = productionWeeks
+
+	summary := fmt.Sprintf("Ranked %d teams at %s by %s", len(rankings.Rankings), strings.Join(rankings.Positions, ", "), mode)
+	if len(rankings.Rankings) > 0 {
+		summary += fmt.Sprintf("; best overall: %s", rankings.Rankings[0].DisplayName)
+	}
+	if len(rankings.TradePartners) > 0 {
+		summary += fmt.Sprintf("; %d complementary trade partner pairs", len(rankings.TradePartners))
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    rankings,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildPositionalRankings ranks every roster at each league position using the roster strength
+// analysis. With production ranks, teams are ranked by points from their starters and depth;
+// without, by how many players they have at the position.
+func buildPositionalRankings(rosters []sleeper.Roster, users []sleeper.User, allPlayers map[string]sleeper.Player, rosterPositions []string, production map[int]map[string]PositionalProduction) *PositionalRankings {
+	requirements := rosterRequirements(rosterPositions)
+	rankings := &PositionalRankings{}
+	for _, requirement := range requirements {
+		rankings.Positions = append(rankings.Positions, requirement.Position)
+	}
+
+	names := make(map[string]string)
+	for _, user := range users {
+		names[user.UserID] = userDisplayName(user)
+	}
+
+	sortedRosters := make([]sleeper.Roster, len(rosters))
+	copy(sortedRosters, rosters)
+	sort.Slice(sortedRosters, func(i, j int) bool {
+		return sortedRosters[i].RosterID < sortedRosters[j].RosterID
+	})
+
+	teams := make([]TeamPositionalRanking, 0, len(sortedRosters))
+	for i := range sortedRosters {
+		roster := &sortedRosters[i]
+		team := TeamPositionalRanking{
+			RosterID:    roster.RosterID,
+			OwnerID:     roster.OwnerID,
+			DisplayName: names[roster.OwnerID],
+		}
+		if team.DisplayName == "" {
+			team.DisplayName = fmt.Sprintf("Team %d", roster.RosterID)
+		}
+
+		for _, positional := range analyzeRosterStrength(roster, allPlayers, rosterPositions).PositionalBreakdown {
+			ranking := PositionRanking{
+				Position: positional.Position,
+				Value:    float64(positional.StarterCount + positional.BenchCount),
+				Strength: positional.Strength,
+			}
+			if ranks, exists := production[roster.RosterID][positional.Position]; exists {
+				ranking.Value = math.Round((ranks.StarterPoints+ranks.DepthPoints)*100) / 100
+				ranking.StarterRank = ranks.StarterRank
+				ranking.DepthRank = ranks.DepthRank
+			}
+			team.Positions = append(team.Positions, ranking)
+		}
+		teams = append(teams, team)
+	}
+
+	// Rank each position across the league; tied teams share a rank
+	teamCount := len(teams)
+	third := int(math.Max(1, math.Floor(float64(teamCount)/3)))
+	for j, requirement := range requirements {
+		for i := range teams {
+			ranking := &teams[i].Positions[j]
+			better := 0
+			for k := range teams {
+				if k != i && teams[k].Positions[j].Value > ranking.Value {
+					better++
+				}
+			}
+			ranking.Rank = better + 1
+
+			if production != nil {
+				switch {
+				case ranking.StarterRank > teamCount-third:
+					ranking.Tier = PositionTierNeed
+				case ranking.DepthRank <= third && production[teams[i].RosterID][requirement.Position].DepthPoints > 0:
+					ranking.Tier = PositionTierSurplus
+				}
+			} else {
+				switch {
+				case ranking.Strength == "Weak":
+					ranking.Tier = PositionTierNeed
+				case int(ranking.Value) > requirement.Depth:
+					ranking.Tier = PositionTierSurplus
+				}
+			}
+		}
+	}
+
+	for i := range teams {
+		team := &teams[i]
+		weightedRanks, weights := 0.0, 0.0
+		for j, ranking := range team.Positions {
+			weight := math.Max(requirements[j].Starters, 0.25)
+			weightedRanks += float64(ranking.Rank) * weight
+			weights += weight
+
+			switch ranking.Tier {
+			case PositionTierSurplus:
+				team.Surpluses = append(team.Surpluses, ranking.Position)
+			case PositionTierNeed:
+				team.Needs = append(team.Needs, ranking.Position)
+			}
+		}
+		if weights > 0 {
+			team.OverallScore = math.Round(weightedRanks/weights*100) / 100
+		}
+
+		rankings.Teams = append(rankings.Teams, team.DisplayName)
+		row := make([]int, len(team.Positions))
+		for j, ranking := range team.Positions {
+			row[j] = ranking.Rank
+		}
+		rankings.HeatMap = append(rankings.HeatMap, row)
+	}
+
+	for i := range teams {
+		better := 0
+		for k := range teams {
+			if k != i && teams[k].OverallScore < teams[i].OverallScore {
+				better++
+			}
+		}
+		teams[i].OverallRank = better + 1
+	}
+
+	rankings.TradePartners = findTradePartners(teams)
+
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].OverallRank < teams[j].OverallRank
+	})
+	rankings.Rankings = teams
+
+	return rankings
+}
+
+// findTradePartners pairs teams where each has a surplus at a position the other needs
+func findTradePartners(teams []TeamPositionalRanking) []TradePartner {
+	covers := func(giver, receiver TeamPositionalRanking) []string {
+		var positions []string
+		for _, surplus := range giver.Surpluses {
+			if containsString(receiver.Needs, surplus) {
+				positions = append(positions, surplus)
+			}
+		}
+		return positions
+	}
+
+	var partners []TradePartner
+	for i := range teams {
+		for j := i + 1; j < len(teams); j++ {
+			firstGives, secondGives := covers(teams[i], teams[j]), covers(teams[j], teams[i])
+			if len(firstGives) == 0 || len(secondGives) == 0 {
+				continue
+			}
+			partners = append(partners, TradePartner{
+				RosterIDs:   [2]int{teams[i].RosterID, teams[j].RosterID},
+				Teams:       [2]string{teams[i].DisplayName, teams[j].DisplayName},
+				FirstGives:  firstGives,
+				SecondGives: secondGives,
+				Description: fmt.Sprintf("%s can trade %s depth for %s's %s depth",
+					teams[i].DisplayName, strings.Join(firstGives, "/"), teams[j].DisplayName, strings.Join(secondGives, "/")),
+			})
+		}
+	}
+	return partners
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestBuildPositionalRankings_Depth(t *testing.T) {
+	rosterPositions := []string{"QB", "RB", "WR", "BN", "BN", "BN"}
+	players := map[string]sleeper.Player{}
+	for _, id := range []string{"qb1", "qb2", "qb3", "qb4"} {
+		players[id] = sleeper.Player{PlayerID: id, Position: "QB"}
+	}
+	for _, id := range []string{"rb1", "rb2", "rb3", "rb4"} {
+		players[id] = sleeper.Player{PlayerID: id, Position: "RB"}
+	}
+	for _, id := range []string{"wr1", "wr2", "wr3", "wr4"} {
+		players[id] = sleeper.Player{PlayerID: id, Position: "WR"}
+	}
+	rosters := []sleeper.Roster{
+		{RosterID: 2, OwnerID: "user2", Players: []string{"qb2", "wr1", "wr2", "wr3"}},
+		{RosterID: 1, OwnerID: "user1", Players: []string{"qb1", "rb1", "rb2", "rb3"}},
+		{RosterID: 3, OwnerID: "user3", Players: []string{"qb3", "qb4", "rb4", "wr4"}},
+	}
+	users := []sleeper.User{{UserID: "user1", DisplayName: "Sam"}, {UserID: "user2", DisplayName: "Alex"}}
+
+	rankings := buildPositionalRankings(rosters, users, players, rosterPositions, nil)
+
+	if len(rankings.Positions) != 3 || rankings.Positions[0] != "QB" {
+		t.Fatalf("Expected QB, RB and WR columns, got %v", rankings.Positions)
+	}
+
+	// Rows follow roster ID order: Sam, Alex, Team 3
+	expectedTeams := []string{"Sam", "Alex", "Team 3"}
+	expectedHeatMap := [][]int{{2, 1, 3}, {2, 3, 1}, {1, 2, 2}}
+	for i, team := range expectedTeams {
+		if rankings.Teams[i] != team {
+			t.Errorf("Expected row %d to be %s, got %s", i, team, rankings.Teams[i])
+		}
+		for j, rank := range expectedHeatMap[i] {
+			if rankings.HeatMap[i][j] != rank {
+				t.Errorf("Expected %s to rank %d at %s, got %d", team, rank, rankings.Positions[j], rankings.HeatMap[i][j])
+			}
+		}
+	}
+
+	if best := rankings.Rankings[0]; best.RosterID != 3 || best.OverallRank != 1 {
+		t.Errorf("Expected team 3's balance to rank first overall, got %+v", best)
+	}
+
+	teams := make(map[int]TeamPositionalRanking)
+	for _, team := range rankings.Rankings {
+		teams[team.RosterID] = team
+	}
+	if sam := teams[1]; len(sam.Surpluses) != 1 || sam.Surpluses[0] != "RB" || len(sam.Needs) != 1 || sam.Needs[0] != "WR" {
+		t.Errorf("Expected Sam to have an RB surplus and a WR need, got %+v", sam)
+	}
+
+	if len(rankings.TradePartners) != 1 {
+		t.Fatalf("Expected 1 trade partner pair, got %d", len(rankings.TradePartners))
+	}
+	partner := rankings.TradePartners[0]
+	if partner.RosterIDs != [2]int{1, 2} || partner.FirstGives[0] != "RB" || partner.SecondGives[0] != "WR" {
+		t.Errorf("Expected Sam's RBs to complement Alex's WRs, got %+v", partner)
+	}
+}
+
+func TestRosterHandler_HandleGetPositionalRankings(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewRosterHandler(newProductionMockClient(), logger)
+
+	result, err := handler.HandleGetPositionalRankings(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool               `json:"success"`
+		Data    PositionalRankings `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	rankings := response.Data
+	if rankings.Mode != StrengthModeProduction || len(rankings.ProductionWeeks) != 3 {
+		t.Errorf("Expected production mode over 3 scored weeks, got %s over %v", rankings.Mode, rankings.ProductionWeeks)
+	}
+	if len(rankings.HeatMap) != 3 || len(rankings.HeatMap[0]) != 2 {
+		t.Fatalf("Expected a 3x2 heat map, got %v", rankings.HeatMap)
+	}
+
+	// Team 2's receivers lead the league but its quarterback trails it
+	for _, team := range rankings.Rankings {
+		if team.RosterID != 2 {
+			continue
+		}
+		if team.Positions[1].Rank != 1 || len(team.Needs) != 1 || team.Needs[0] != "QB" {
+			t.Errorf("Expected team 2 to rank 1st at WR and need a QB, got %+v", team)
+		}
+	}
+
+	if _, err := handler.HandleGetPositionalRankings(context.Background(), map[string]interface{}{"league_id": "league1", "mode": "vibes"}); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
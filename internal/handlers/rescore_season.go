@@ -0,0 +1,486 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// scoringPresets are common scoring changes that can be applied by name
+var scoringPresets = map[string]map[string]float64{
+	"standard":    {"rec": 0},
+	"half_ppr":    {"rec": 0.5},
+	"ppr":         {"rec": 1},
+	"6pt_pass_td": {"pass_td": 6},
+}
+
+// ScoringChange represents one scoring setting that differs from the league's
+type ScoringChange struct {
+	Stat         string  `json:"stat"`
+	Current      float64 `json:"current"`
+	Hypothetical float64 `json:"hypothetical"`
+}
+
+// RescoredGame represents a game whose result changes under the hypothetical scoring
+type RescoredGame struct {
+	Week                   int     `json:"week"`
+	RosterID               int     `json:"roster_id"`
+	DisplayName            string  `json:"display_name"`
+	OpponentRosterID       int     `json:"opponent_roster_id"`
+	OpponentDisplayName    string  `json:"opponent_display_name"`
+	ActualPoints           float64 `json:"actual_points"`
+	ActualOpponentPoints   float64 `json:"actual_opponent_points"`
+	RescoredPoints         float64 `json:"rescored_points"`
+	RescoredOpponentPoints float64 `json:"rescored_opponent_points"`
+	ActualResult           string  `json:"actual_result"`
+	RescoredResult         string  `json:"rescored_result"`
+}
+
+// RescoredTeam represents one team's season under actual and hypothetical scoring
+type RescoredTeam struct {
+	RosterID          int     `json:"roster_id"`
+	OwnerID           string  `json:"owner_id"`
+	DisplayName       string  `json:"display_name"`
+	ActualRecord      string  `json:"actual_record"`
+	RescoredRecord    string  `json:"rescored_record"`
+	ActualPointsFor   float64 `json:"actual_points_for"`
+	RescoredPointsFor float64 `json:"rescored_points_for"`
+	PointsForChange   float64 `json:"points_for_change"`
+	ActualRank        int     `json:"actual_rank"`
+	RescoredRank      int     `json:"rescored_rank"`
+	RankChange        int     `json:"rank_change"` // Positive when the team moves up
+	ActualSeed        int     `json:"actual_seed,omitempty"`
+	RescoredSeed      int     `json:"rescored_seed,omitempty"`
+}
+
+// SeasonRescore represents a season replayed under hypothetical scoring settings
+type SeasonRescore struct {
+	LeagueID       string          `json:"league_id"`
+	Season         string          `json:"season"`
+	StatsSource    string          `json:"stats_source"` // "sleeper_api" or the stats file name
+	ScoringChanges []ScoringChange `json:"scoring_changes"`
+	StartWeek      int             `json:"start_week"`
+	EndWeek        int             `json:"end_week"`
+	GamesRescored  int             `json:"games_rescored"`
+	FlippedGames   []RescoredGame  `json:"flipped_games"`
+	Teams          []RescoredTeam  `json:"teams"` // Ordered by rescored rank
+	PlayoffChanges []string        `json:"playoff_changes,omitempty"`
+	Warnings       []string        `json:"warnings,omitempty"`
+}
+
+// rescoreWeek holds one week's matchups and player stats
+type rescoreWeek struct {
+	Week     int
+	Matchups []sleeper.Matchup
+	Stats    map[string]sleeper.PlayerStats
+}
+
+// RescoreSeasonTool returns the MCP tool definition for rescore_season
+func (h *LeagueHandler) RescoreSeasonTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "rescore_season",
+		Description: "Replay a season's regular season matchups under hypothetical scoring settings (e.g. half-PPR instead of PPR, or 6-point passing TDs) and report which games flip and how the standings and playoff field would change",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID for the season to rescore",
+					"required":    true,
+				},
+				"preset": map[string]interface{}{
+					"type":        "string",
+					"description": "Named scoring change: standard, half_ppr, ppr or 6pt_pass_td",
+					"required":    false,
+				},
+				"scoring_overrides": map[string]interface{}{
+					"type":        "object",
+					"description": "Scoring settings to change, keyed by Sleeper stat key (e.g. {\"rec\": 0.5, \"pass_td\": 6}). Applied after the preset.",
+					"required":    false,
+				},
+				"stats_file": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of a JSON file of weekly player stats ({\"<week>\": {\"<player_id>\": {\"<stat>\": value}}}) in the configured stats_dir to use instead of Sleeper's stats endpoint",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleRescoreSeason handles the rescore_season tool call
+func (h *LeagueHandler) HandleRescoreSeason(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling rescore_season")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	changes := make(map[string]float64)
+	preset, _ := args["preset"].(string)
+	if preset != "" {
+		presetChanges, exists := scoringPresets[preset]
+		if !exists {
+			return toolErrorResult(fmt.Sprintf("Unknown scoring preset %q", preset)), nil
+		}
+		for stat, value := range presetChanges {
+			changes[stat] = value
+		}
+	}
+	if overridesRaw, exists := args["scoring_overrides"]; exists {
+		overrides, ok := overridesRaw.(map[string]interface{})
+		if !ok {
+			return toolErrorResult("scoring_overrides must be an object of stat keys to points"), nil
+		}
+		for stat, valueRaw := range overrides {
+			value, ok := valueRaw.(float64)
+			if !ok {
+				return toolErrorResult(fmt.Sprintf("scoring_overrides[%q] must be a number", stat)), nil
+			}
+			changes[stat] = value
+		}
+	}
+	if len(changes) == 0 {
+		return toolErrorResult("Provide a preset or scoring_overrides to rescore with"), nil
+	}
+
+	var fileStats map[int]map[string]sleeper.PlayerStats
+	statsSource := "sleeper_api"
+	if statsFile, _ := args["stats_file"].(string); statsFile != "" {
+		statsDir := ""
+		if h.config != nil {
+			statsDir = h.config.StatsDir
+		}
+		var err error
+		fileStats, err = loadStatsFile(statsDir, statsFile)
+		if err != nil {
+			return toolErrorResult(fmt.Sprintf("Failed to load stats file: %s", err.Error())), nil
+		}
+		statsSource = statsFile
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league info: %s", err.Error())), nil
+	}
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league users")
+		return toolErrorResult(fmt.Sprintf("Failed to get league users: %s", err.Error())), nil
+	}
+	apiCalls := 3
+
+	weeks, warnings, calls := h.loadRescoreWeeks(leagueID, league, fileStats)
+	apiCalls += calls
+
+	hypothetical := make(map[string]float64, len(league.ScoringSettings)+len(changes))
+	for stat, value := range league.ScoringSettings {
+		hypothetical[stat] = value
+	}
+	for stat, value := range changes {
+		hypothetical[stat] = value
+	}
+
+	rescore, actual, rescored := buildSeasonRescore(league, rosters, users, weeks, hypothetical)
+
+	// Rank both seasons the way get_league_standings would, with the league's
+	// configured tiebreakers, instructions and playoff seeding
+	actualStandings, calls, err := h.computeStandings(leagueID, standingsRequest{Records: actual})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to rank actual standings")
+		return toolErrorResult(fmt.Sprintf("Failed to rank standings: %s", err.Error())), nil
+	}
+	apiCalls += calls
+	rescoredStandings, calls, err := h.computeStandings(leagueID, standingsRequest{Records: rescored})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to rank rescored standings")
+		return toolErrorResult(fmt.Sprintf("Failed to rank standings: %s", err.Error())), nil
+	}
+	apiCalls += calls
+	compareRescoredStandings(rescore, actualStandings, rescoredStandings)
+
+	rescore.LeagueID = leagueID
+	rescore.StatsSource = statsSource
+	rescore.Warnings = append(warnings, rescore.Warnings...)
+
+	moved := 0
+	for _, team := range rescore.Teams {
+		if team.RankChange != 0 {
+			moved++
+		}
+	}
+	summary := fmt.Sprintf("Rescored %d games from weeks %d-%d: %d results flip, %d teams change position",
+		rescore.GamesRescored, rescore.StartWeek, rescore.EndWeek, len(rescore.FlippedGames), moved)
+	if len(rescore.PlayoffChanges) > 0 {
+		summary += fmt.Sprintf(", %d playoff field changes", len(rescore.PlayoffChanges))
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    rescore,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// loadRescoreWeeks fetches each scored regular season week's matchups, with player
+// stats from the stats file when given and Sleeper's stats endpoint otherwise
+func (h *LeagueHandler) loadRescoreWeeks(leagueID string, league *sleeper.League, fileStats map[int]map[string]sleeper.PlayerStats) ([]rescoreWeek, []string, int) {
+	var weeks []rescoreWeek
+	var warnings []string
+	apiCalls := 0
+
+	startWeek, endWeek := regularSeasonWeeks(league)
+	if league.Settings.LastScoredLeg > 0 && league.Settings.LastScoredLeg < endWeek {
+		endWeek = league.Settings.LastScoredLeg
+	}
+
+	for week := startWeek; week <= endWeek; week++ {
+		matchups, err := h.client.GetMatchups(leagueID, week)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithField("week", week).Warn("Failed to get matchups for week")
+			warnings = append(warnings, fmt.Sprintf("Week %d: failed to get matchups (%s)", week, err.Error()))
+			continue
+		}
+		if !weekHasScores(matchups) {
+			break
+		}
+
+		stats := fileStats[week]
+		if fileStats == nil {
+			stats, err = h.client.GetWeeklyStats("regular", league.Season, week)
+			apiCalls++
+			if err != nil {
+				h.logger.WithError(err).WithField("week", week).Warn("Failed to get weekly stats")
+				warnings = append(warnings, fmt.Sprintf("Week %d: failed to get stats, scores are unchanged (%s)", week, err.Error()))
+			}
+		}
+
+		weeks = append(weeks, rescoreWeek{Week: week, Matchups: matchups, Stats: stats})
+	}
+
+	return weeks, warnings, apiCalls
+}
+
+// loadStatsFile reads weekly player stats, keyed by week then player ID, from a file
+// inside the stats directory. Errors name the file but never echo its contents.
+func loadStatsFile(statsDir, name string) (map[int]map[string]sleeper.PlayerStats, error) {
+	if statsDir == "" {
+		return nil, fmt.Errorf("no stats_dir is configured in configs/league_settings.json")
+	}
+	if filepath.IsAbs(name) {
+		return nil, fmt.Errorf("stats_file %q must be a file name inside stats_dir, not an absolute path", name)
+	}
+	for _, element := range strings.Split(filepath.ToSlash(name), "/") {
+		if element == ".." {
+			return nil, fmt.Errorf("stats_file %q must not contain \"..\"", name)
+		}
+	}
+	if !filepath.IsLocal(name) {
+		return nil, fmt.Errorf("stats_file %q must be a file name inside stats_dir", name)
+	}
+
+	data, err := os.ReadFile(filepath.Join(statsDir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("stats file %q not found in stats_dir", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats file %q", name)
+	}
+
+	var raw map[string]map[string]sleeper.PlayerStats
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("stats file %q is not a JSON object of weeks to player stats", name)
+	}
+
+	stats := make(map[int]map[string]sleeper.PlayerStats, len(raw))
+	for weekKey, weekStats := range raw {
+		week, err := strconv.Atoi(weekKey)
+		if err != nil {
+			return nil, fmt.Errorf("stats file %q has a key that isn't a week number", name)
+		}
+		stats[week] = weekStats
+	}
+	return stats, nil
+}
+
+// buildSeasonRescore replays each week under both scorings and returns the rescore
+// with the actual and rescored records. Each starter's points are adjusted by the
+// difference between their stats scored both ways, so commissioner corrections and
+// stats Sleeper scored differently carry over unchanged.
+func buildSeasonRescore(league *sleeper.League, rosters []sleeper.Roster, users []sleeper.User, weeks []rescoreWeek, hypothetical map[string]float64) (*SeasonRescore, map[int]*RecomputedRecord, map[int]*RecomputedRecord) {
+	rescore := &SeasonRescore{Season: league.Season}
+
+	for stat, value := range hypothetical {
+		if current := league.ScoringSettings[stat]; current != value {
+			rescore.ScoringChanges = append(rescore.ScoringChanges, ScoringChange{Stat: stat, Current: current, Hypothetical: value})
+		}
+	}
+	sort.Slice(rescore.ScoringChanges, func(i, j int) bool {
+		return rescore.ScoringChanges[i].Stat < rescore.ScoringChanges[j].Stat
+	})
+
+	names := make(map[string]string)
+	for _, user := range users {
+		names[user.UserID] = userDisplayName(user)
+	}
+	ownerByRoster := make(map[int]string)
+	for _, roster := range rosters {
+		ownerByRoster[roster.RosterID] = roster.OwnerID
+	}
+	rosterName := func(rosterID int) string {
+		return names[ownerByRoster[rosterID]]
+	}
+
+	medianScoring := league.Settings.LeagueAverageMatch == 1
+	actual := make(map[int]*RecomputedRecord)
+	rescored := make(map[int]*RecomputedRecord)
+
+	for _, week := range weeks {
+		if rescore.StartWeek == 0 {
+			rescore.StartWeek = week.Week
+		}
+		rescore.EndWeek = week.Week
+
+		missingStats := 0
+		rescoredPoints := make(map[int]float64)
+		for _, matchup := range week.Matchups {
+			points := matchupPoints(matchup)
+			for _, playerID := range matchup.Starters {
+				stats, exists := week.Stats[playerID]
+				if !exists {
+					if playerID != "0" && matchup.PlayersPoints[playerID] != 0 {
+						missingStats++
+					}
+					continue
+				}
+				points += scorePlayerStats(stats, hypothetical) - scorePlayerStats(stats, league.ScoringSettings)
+			}
+			rescoredPoints[matchup.RosterID] = math.Round(points*100) / 100
+		}
+		if missingStats > 0 {
+			rescore.Warnings = append(rescore.Warnings, fmt.Sprintf("Week %d: no stats for %d scoring starters, their points are unchanged", week.Week, missingStats))
+		}
+
+		rescore.Warnings = append(rescore.Warnings, tallyWeek(actual, week.Week, week.Matchups, matchupPoints, medianScoring)...)
+		tallyWeek(rescored, week.Week, week.Matchups, func(matchup sleeper.Matchup) float64 {
+			return rescoredPoints[matchup.RosterID]
+		}, medianScoring)
+
+		for _, pair := range groupMatchupsByID(week.Matchups) {
+			if len(pair) != 2 {
+				continue
+			}
+			rescore.GamesRescored++
+
+			team, opponent := pair[0], pair[1]
+			actualResult := gameResult(matchupPoints(team), matchupPoints(opponent))
+			rescoredResult := gameResult(rescoredPoints[team.RosterID], rescoredPoints[opponent.RosterID])
+			if actualResult == rescoredResult {
+				continue
+			}
+
+			// Describe flips from the side that wins under the hypothetical scoring
+			if rescoredResult == "L" {
+				team, opponent = opponent, team
+				actualResult = gameResult(matchupPoints(team), matchupPoints(opponent))
+				rescoredResult = gameResult(rescoredPoints[team.RosterID], rescoredPoints[opponent.RosterID])
+			}
+			rescore.FlippedGames = append(rescore.FlippedGames, RescoredGame{
+				Week:                   week.Week,
+				RosterID:               team.RosterID,
+				DisplayName:            rosterName(team.RosterID),
+				OpponentRosterID:       opponent.RosterID,
+				OpponentDisplayName:    rosterName(opponent.RosterID),
+				ActualPoints:           matchupPoints(team),
+				ActualOpponentPoints:   matchupPoints(opponent),
+				RescoredPoints:         rescoredPoints[team.RosterID],
+				RescoredOpponentPoints: rescoredPoints[opponent.RosterID],
+				ActualResult:           actualResult,
+				RescoredResult:         rescoredResult,
+			})
+		}
+	}
+
+	return rescore, actual, rescored
+}
+
+// compareRescoredStandings fills in each team's actual and rescored records, ranks and
+// seeds, ordered by rescored rank, and the resulting playoff field changes
+func compareRescoredStandings(rescore *SeasonRescore, actualStandings, rescoredStandings []StandingEntry) {
+	before := make(map[int]StandingEntry)
+	for _, entry := range actualStandings {
+		before[entry.RosterID] = entry
+	}
+
+	for _, entry := range rescoredStandings {
+		actual := before[entry.RosterID]
+		team := RescoredTeam{
+			RosterID:          entry.RosterID,
+			OwnerID:           entry.OwnerID,
+			DisplayName:       entry.DisplayName,
+			ActualRecord:      fmt.Sprintf("%d-%d-%d", actual.Wins, actual.Losses, actual.Ties),
+			RescoredRecord:    fmt.Sprintf("%d-%d-%d", entry.Wins, entry.Losses, entry.Ties),
+			ActualPointsFor:   math.Round(actual.PointsFor*100) / 100,
+			RescoredPointsFor: math.Round(entry.PointsFor*100) / 100,
+			PointsForChange:   math.Round((entry.PointsFor-actual.PointsFor)*100) / 100,
+			ActualRank:        actual.Rank,
+			RescoredRank:      entry.Rank,
+			RankChange:        actual.Rank - entry.Rank,
+			ActualSeed:        actual.PlayoffSeed,
+			RescoredSeed:      entry.PlayoffSeed,
+		}
+		rescore.Teams = append(rescore.Teams, team)
+
+		switch {
+		case team.ActualSeed == 0 && team.RescoredSeed > 0:
+			rescore.PlayoffChanges = append(rescore.PlayoffChanges, fmt.Sprintf("%s makes the playoffs as the %d seed", team.DisplayName, team.RescoredSeed))
+		case team.ActualSeed > 0 && team.RescoredSeed == 0:
+			rescore.PlayoffChanges = append(rescore.PlayoffChanges, fmt.Sprintf("%s misses the playoffs (was the %d seed)", team.DisplayName, team.ActualSeed))
+		case team.ActualSeed != team.RescoredSeed:
+			rescore.PlayoffChanges = append(rescore.PlayoffChanges, fmt.Sprintf("%s moves from the %d seed to the %d seed", team.DisplayName, team.ActualSeed, team.RescoredSeed))
+		}
+	}
+}
+
+// headToHeadFromRecords builds a head-to-head wins matrix from recomputed weekly results
+func headToHeadFromRecords(records map[int]*RecomputedRecord) map[int]map[int]int {
+	matrix := make(map[int]map[int]int)
+	for rosterID, record := range records {
+		matrix[rosterID] = make(map[int]int)
+		for _, week := range record.Weeks {
+			if week.Result == "W" && week.OpponentRosterID != 0 {
+				matrix[rosterID][week.OpponentRosterID]++
+			}
+		}
+	}
+	return matrix
+}
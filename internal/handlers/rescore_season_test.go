@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/config"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLeagueHandler_RescoreSeason(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	league := &sleeper.League{
+		LeagueID:        "league1",
+		Season:          "2024",
+		ScoringSettings: map[string]float64{"rec": 1, "rec_yd": 0.1, "pass_td": 4},
+		Settings:        sleeper.LeagueSettings{PlayoffTeams: 2, LastScoredLeg: 2, PlayoffWeekStart: 3},
+	}
+	users := []sleeper.User{
+		{UserID: "user1", DisplayName: "Receivers"},
+		{UserID: "user2", DisplayName: "Passers"},
+		{UserID: "user3", DisplayName: "Middle"},
+		{UserID: "user4", DisplayName: "Bottom"},
+	}
+	rosters := []sleeper.Roster{
+		{RosterID: 1, OwnerID: "user1"}, {RosterID: 2, OwnerID: "user2"},
+		{RosterID: 3, OwnerID: "user3"}, {RosterID: 4, OwnerID: "user4"},
+	}
+	// Roster 1 wins both weeks on receptions; roster 2's quarterback throws 3 TDs a week
+	matchups := map[int][]sleeper.Matchup{
+		1: {
+			{RosterID: 1, MatchupID: 1, Points: 100, Starters: []string{"wr1"}, PlayersPoints: map[string]float64{"wr1": 20}},
+			{RosterID: 2, MatchupID: 1, Points: 95, Starters: []string{"qb1"}, PlayersPoints: map[string]float64{"qb1": 12}},
+			{RosterID: 3, MatchupID: 2, Points: 90},
+			{RosterID: 4, MatchupID: 2, Points: 80},
+		},
+		2: {
+			{RosterID: 1, MatchupID: 1, Points: 110, Starters: []string{"wr1"}, PlayersPoints: map[string]float64{"wr1": 20}},
+			{RosterID: 3, MatchupID: 1, Points: 100},
+			{RosterID: 2, MatchupID: 2, Points: 70, Starters: []string{"qb1"}, PlayersPoints: map[string]float64{"qb1": 12}},
+			{RosterID: 4, MatchupID: 2, Points: 60},
+		},
+	}
+	weekStats := map[string]map[string]sleeper.PlayerStats{
+		"1": {"wr1": {"rec": 10, "rec_yd": 100}, "qb1": {"pass_td": 3}},
+		"2": {"wr1": {"rec": 10, "rec_yd": 100}, "qb1": {"pass_td": 3}},
+	}
+
+	statsDir := t.TempDir()
+	data, _ := json.Marshal(weekStats)
+	if err := os.WriteFile(filepath.Join(statsDir, "stats.json"), data, 0o644); err != nil {
+		t.Fatalf("Failed to write stats file: %v", err)
+	}
+
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return league, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return rosters, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return users, nil
+		},
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			return matchups[week], nil
+		},
+	}
+	handler := NewLeagueHandler(mockClient, logger)
+	handler.config = &config.LeagueConfig{StatsDir: statsDir}
+
+	// Half-PPR costs roster 1 5 points a week; 6 point passing TDs add 6 to roster 2
+	result, err := handler.HandleRescoreSeason(context.Background(), map[string]interface{}{
+		"league_id":         "league1",
+		"preset":            "half_ppr",
+		"scoring_overrides": map[string]interface{}{"pass_td": float64(6)},
+		"stats_file":        "stats.json",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %s", result.Content[0].(*mcp.TextContent).Text)
+	}
+
+	var response struct {
+		Data SeasonRescore `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	rescore := response.Data
+
+	if len(rescore.ScoringChanges) != 2 || rescore.GamesRescored != 4 {
+		t.Fatalf("Expected 2 scoring changes and 4 games, got %d and %d", len(rescore.ScoringChanges), rescore.GamesRescored)
+	}
+	if len(rescore.FlippedGames) != 1 {
+		t.Fatalf("Expected 1 flipped game, got %d", len(rescore.FlippedGames))
+	}
+	flipped := rescore.FlippedGames[0]
+	if flipped.Week != 1 || flipped.RosterID != 2 || flipped.RescoredPoints != 101 || flipped.RescoredOpponentPoints != 95 || flipped.ActualResult != "L" {
+		t.Errorf("Expected roster 2 to win week 1 101-95, got %+v", flipped)
+	}
+
+	teams := make(map[int]RescoredTeam)
+	for _, team := range rescore.Teams {
+		teams[team.RosterID] = team
+	}
+	if team := teams[1]; team.ActualRecord != "2-0-0" || team.RescoredRecord != "1-1-0" || team.PointsForChange != -10 {
+		t.Errorf("Expected roster 1 to drop from 2-0-0 to 1-1-0 and lose 10 points, got %+v", team)
+	}
+	if team := teams[2]; team.ActualRank != 3 || team.RescoredRank != 1 || team.RankChange != 2 || team.RescoredSeed != 1 {
+		t.Errorf("Expected roster 2 to climb from 3rd to the 1 seed, got %+v", team)
+	}
+	if len(rescore.PlayoffChanges) == 0 {
+		t.Error("Expected the playoff field to change")
+	}
+
+	// Custom instructions apply to the rescored standings: rosters 1 and 3 finish 1-1,
+	// and roster 3 allowed fewer points (185 vs 201)
+	handler.config.Leagues = map[string]config.LeagueSettings{
+		"league1": {Custom: config.CustomStandings{Enabled: true, Instructions: "Break ties on wins by fewest points against"}},
+	}
+	result, err = handler.HandleRescoreSeason(context.Background(), map[string]interface{}{
+		"league_id":         "league1",
+		"preset":            "half_ppr",
+		"scoring_overrides": map[string]interface{}{"pass_td": float64(6)},
+		"stats_file":        "stats.json",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	for _, team := range response.Data.Teams {
+		if team.RosterID == 3 && team.RescoredRank != 2 {
+			t.Errorf("Expected roster 3 to rank 2nd on points against, got %+v", team)
+		}
+	}
+}
+
+func TestLoadStatsFile(t *testing.T) {
+	statsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(statsDir, "stats.json"), []byte(`{"1": {"wr1": {"rec": 10}}}`), 0o644); err != nil {
+		t.Fatalf("Failed to write stats file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(statsDir, "secret.txt"), []byte("password=hunter2"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	stats, err := loadStatsFile(statsDir, "stats.json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stats[1]["wr1"]["rec"] != 10 {
+		t.Errorf("Expected week 1 stats for wr1, got %+v", stats)
+	}
+
+	tests := []struct {
+		name     string
+		statsDir string
+		file     string
+	}{
+		{"no stats dir", "", "stats.json"},
+		{"absolute path", statsDir, filepath.Join(statsDir, "stats.json")},
+		{"parent directory", statsDir, "../stats.json"},
+		{"parent inside path", statsDir, "nested/../stats.json"},
+		{"missing file", statsDir, "missing.json"},
+		{"not stats", statsDir, "secret.txt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadStatsFile(tt.statsDir, tt.file)
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if strings.Contains(err.Error(), "hunter2") {
+				t.Errorf("Expected error not to echo the file's contents, got %q", err.Error())
+			}
+			if !filepath.IsAbs(tt.file) && tt.statsDir != "" && strings.Contains(err.Error(), tt.statsDir) {
+				t.Errorf("Expected error not to reveal the stats directory, got %q", err.Error())
+			}
+		})
+	}
+}
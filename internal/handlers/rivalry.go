@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// RivalryGame represents one head-to-head game between two owners
+type RivalryGame struct {
+	Season       string  `json:"season"`
+	Week         int     `json:"week"`
+	GameType     string  `json:"game_type"`
+	Owner1Points float64 `json:"owner1_points"`
+	Owner2Points float64 `json:"owner2_points"`
+	WinnerID     string  `json:"winner_id,omitempty"` // Empty on a tie
+	Margin       float64 `json:"margin"`
+}
+
+// RivalrySide represents one owner's side of a rivalry
+type RivalrySide struct {
+	OwnerID     string       `json:"owner_id"`
+	DisplayName string       `json:"display_name"`
+	Wins        int          `json:"wins"`
+	PointsFor   float64      `json:"points_for"`
+	BiggestWin  *RivalryGame `json:"biggest_win,omitempty"`
+}
+
+// RivalryStreak represents the active run of consecutive wins in a rivalry
+type RivalryStreak struct {
+	OwnerID     string `json:"owner_id,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Length      int    `json:"length"`
+}
+
+// Rivalry represents two owners' all-time head-to-head history
+type Rivalry struct {
+	Owner1         RivalrySide    `json:"owner1"`
+	Owner2         RivalrySide    `json:"owner2"`
+	Ties           int            `json:"ties"`
+	GamesPlayed    int            `json:"games_played"`
+	Record         string         `json:"record"`         // Owner 1's W-L-T
+	RegularSeason  string         `json:"regular_season"` // Owner 1's W-L-T in regular season games
+	Playoffs       string         `json:"playoffs"`       // Owner 1's W-L-T in winners bracket games
+	CurrentStreak  *RivalryStreak `json:"current_streak,omitempty"`
+	Games          []RivalryGame  `json:"games"`
+	SeasonsScanned []string       `json:"seasons_scanned"`
+	Warnings       []string       `json:"warnings,omitempty"`
+}
+
+// GetRivalryTool returns the MCP tool definition for get_rivalry
+func (h *LeagueHandler) GetRivalryTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_rivalry",
+		Description: "Get two owners' all-time head-to-head record across every discovered season, including playoffs, with each game's score, biggest wins and the current streak",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Current league ID (will auto-discover historical seasons)",
+					"required":    true,
+				},
+				"owner1": map[string]interface{}{
+					"type":        "string",
+					"description": "First owner's user ID, display name or username",
+					"required":    true,
+				},
+				"owner2": map[string]interface{}{
+					"type":        "string",
+					"description": "Second owner's user ID, display name or username",
+					"required":    true,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetRivalry handles the get_rivalry tool call
+func (h *LeagueHandler) HandleGetRivalry(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_rivalry")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+	owner1Query, ok := args["owner1"].(string)
+	if !ok || owner1Query == "" {
+		return nil, fmt.Errorf("owner1 is required and must be a string")
+	}
+	owner2Query, ok := args["owner2"].(string)
+	if !ok || owner2Query == "" {
+		return nil, fmt.Errorf("owner2 is required and must be a string")
+	}
+
+	history, err := h.loadLeagueGameHistory(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to load league history")
+		return toolErrorResult(fmt.Sprintf("Failed to load league history: %s", err.Error())), nil
+	}
+
+	owner1, err := history.Owners.resolve(owner1Query)
+	if err != nil {
+		return toolErrorResult(err.Error()), nil
+	}
+	owner2, err := history.Owners.resolve(owner2Query)
+	if err != nil {
+		return toolErrorResult(err.Error()), nil
+	}
+	if owner1 == owner2 {
+		return toolErrorResult(fmt.Sprintf("%q and %q are the same owner", owner1Query, owner2Query)), nil
+	}
+
+	rivalry := buildRivalry(history, owner1, owner2)
+
+	summary := fmt.Sprintf("%s vs %s: %s in %d games", rivalry.Owner1.DisplayName, rivalry.Owner2.DisplayName, rivalry.Record, rivalry.GamesPlayed)
+	if rivalry.CurrentStreak != nil {
+		summary += fmt.Sprintf(", %s has won %d straight", rivalry.CurrentStreak.DisplayName, rivalry.CurrentStreak.Length)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    rivalry,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: history.APICalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildRivalry collects every game between two owners, oldest first
+func buildRivalry(history *leagueGameHistory, owner1, owner2 string) *Rivalry {
+	names := history.ownerNames()
+	rivalry := &Rivalry{
+		Owner1:   RivalrySide{OwnerID: owner1, DisplayName: names[owner1]},
+		Owner2:   RivalrySide{OwnerID: owner2, DisplayName: names[owner2]},
+		Warnings: history.Warnings,
+	}
+
+	type tally struct{ wins, losses, ties int }
+	var regular, playoffs tally
+
+	for _, gameLog := range history.Seasons {
+		rivalry.SeasonsScanned = append(rivalry.SeasonsScanned, gameLog.Season)
+
+		// Each game appears once per team; owner 1's row describes it
+		for _, game := range gameLog.Games {
+			if game.OwnerID != owner1 || game.OpponentOwnerID != owner2 {
+				continue
+			}
+
+			rivalryGame := RivalryGame{
+				Season:       game.Season,
+				Week:         game.Week,
+				GameType:     game.GameType,
+				Owner1Points: game.PointsFor,
+				Owner2Points: game.PointsAgainst,
+				Margin:       math.Round(math.Abs(game.PointsFor-game.PointsAgainst)*100) / 100,
+			}
+			rivalry.Owner1.PointsFor += game.PointsFor
+			rivalry.Owner2.PointsFor += game.PointsAgainst
+
+			var counter *tally
+			switch game.GameType {
+			case GameTypeRegular:
+				counter = &regular
+			case GameTypePlayoff:
+				counter = &playoffs
+			}
+
+			switch game.Result {
+			case "W":
+				rivalryGame.WinnerID = owner1
+				rivalry.Owner1.Wins++
+				trackBiggestWin(&rivalry.Owner1, rivalryGame)
+				if counter != nil {
+					counter.wins++
+				}
+			case "L":
+				rivalryGame.WinnerID = owner2
+				rivalry.Owner2.Wins++
+				trackBiggestWin(&rivalry.Owner2, rivalryGame)
+				if counter != nil {
+					counter.losses++
+				}
+			default:
+				rivalry.Ties++
+				if counter != nil {
+					counter.ties++
+				}
+			}
+
+			rivalry.Games = append(rivalry.Games, rivalryGame)
+		}
+	}
+
+	rivalry.GamesPlayed = len(rivalry.Games)
+	rivalry.Owner1.PointsFor = math.Round(rivalry.Owner1.PointsFor*100) / 100
+	rivalry.Owner2.PointsFor = math.Round(rivalry.Owner2.PointsFor*100) / 100
+	rivalry.Record = fmt.Sprintf("%d-%d-%d", rivalry.Owner1.Wins, rivalry.Owner2.Wins, rivalry.Ties)
+	rivalry.RegularSeason = fmt.Sprintf("%d-%d-%d", regular.wins, regular.losses, regular.ties)
+	rivalry.Playoffs = fmt.Sprintf("%d-%d-%d", playoffs.wins, playoffs.losses, playoffs.ties)
+
+	// The current streak counts back from the most recent game until the winner changes
+	for i := len(rivalry.Games) - 1; i >= 0; i-- {
+		winnerID := rivalry.Games[i].WinnerID
+		if winnerID == "" {
+			break
+		}
+		if rivalry.CurrentStreak == nil {
+			rivalry.CurrentStreak = &RivalryStreak{OwnerID: winnerID, DisplayName: names[winnerID]}
+		}
+		if winnerID != rivalry.CurrentStreak.OwnerID {
+			break
+		}
+		rivalry.CurrentStreak.Length++
+	}
+
+	return rivalry
+}
+
+// trackBiggestWin keeps the largest winning margin for a rivalry side
+func trackBiggestWin(side *RivalrySide, game RivalryGame) {
+	if side.BiggestWin == nil || game.Margin > side.BiggestWin.Margin {
+		biggest := game
+		side.BiggestWin = &biggest
+	}
+}
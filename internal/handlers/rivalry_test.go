@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLeagueHandler_GetRivalry(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(newHistoryMockClient(), logger)
+
+	result, err := handler.HandleGetRivalry(context.Background(), map[string]interface{}{
+		"league_id": "league2024",
+		"owner1":    "player one",
+		"owner2":    "user2",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %s", result.Content[0].(*mcp.TextContent).Text)
+	}
+
+	var response struct {
+		Data Rivalry `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	rivalry := response.Data
+
+	if rivalry.Owner1.OwnerID != "user1" || rivalry.GamesPlayed != 5 {
+		t.Fatalf("Expected 5 games for user1, got %d for %s", rivalry.GamesPlayed, rivalry.Owner1.OwnerID)
+	}
+	if rivalry.Record != "2-3-0" || rivalry.RegularSeason != "1-3-0" || rivalry.Playoffs != "1-0-0" {
+		t.Errorf("Expected 2-3-0 overall, 1-3-0 regular season and 1-0-0 playoffs, got %s, %s, %s",
+			rivalry.Record, rivalry.RegularSeason, rivalry.Playoffs)
+	}
+
+	// Roster IDs swap in 2024, so user1's 2024 scores come from roster 2
+	last := rivalry.Games[len(rivalry.Games)-1]
+	if last.Season != "2024" || last.Owner1Points != 100 || last.Owner2Points != 110 {
+		t.Errorf("Expected user1 to score 100 against 110 in the last game, got %+v", last)
+	}
+	if rivalry.CurrentStreak == nil || rivalry.CurrentStreak.OwnerID != "user2" || rivalry.CurrentStreak.Length != 2 {
+		t.Errorf("Expected user2 to have a 2 game streak, got %+v", rivalry.CurrentStreak)
+	}
+	if rivalry.Owner2.BiggestWin == nil || rivalry.Owner2.BiggestWin.Margin != 70 {
+		t.Errorf("Expected user2's biggest win by 70, got %+v", rivalry.Owner2.BiggestWin)
+	}
+
+	result, _ = handler.HandleGetRivalry(context.Background(), map[string]interface{}{
+		"league_id": "league2024",
+		"owner1":    "user1",
+		"owner2":    "nobody",
+	})
+	if !result.IsError {
+		t.Error("Expected error result for an unknown owner")
+	}
+}
@@ -35,15 +35,17 @@ type CompareRostersArgs struct {
 
 // RosterHandler handles roster-related MCP tools
 type RosterHandler struct {
-	client sleeper.Client
-	logger *logrus.Logger
+	client   sleeper.Client
+	logger   *logrus.Logger
+	schedule *scheduleLoader
 }
 
 // NewRosterHandler creates a new roster handler
 func NewRosterHandler(client sleeper.Client, logger *logrus.Logger) *RosterHandler {
 	return &RosterHandler{
-		client: client,
-		logger: logger,
+		client:   client,
+		logger:   logger,
+		schedule: newScheduleLoader(logger),
 	}
 }
 
@@ -92,7 +94,7 @@ func (h *RosterHandler) GetAllRostersTool() mcp.Tool {
 func (h *RosterHandler) AnalyzeRosterStrengthTool() mcp.Tool {
 	return mcp.Tool{
 		Name:        "analyze_roster_strength",
-		Description: "Analyze team roster strength with positional breakdown, starter quality, and injury assessment",
+		Description: "Analyze team roster strength with positional breakdown, starter quality, and injury assessment. Production mode ranks each position group against the rest of the league by points scored (e.g. \"your WR corps is 9th of 12\")",
 		InputSchema: mcp.ToolInputSchema{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -106,6 +108,16 @@ func (h *RosterHandler) AnalyzeRosterStrengthTool() mcp.Tool {
 					"description": "The roster ID of the team to analyze",
 					"required":    true,
 				},
+				"mode": map[string]interface{}{
+					"type":        "string",
+					"description": "depth (default) rates headcounts against the lineup; production also ranks each position's starters and depth league-wide by fantasy points scored",
+					"required":    false,
+				},
+				"weeks": map[string]interface{}{
+					"type":        "integer",
+					"description": "In production mode, only count the last N scored weeks (default: whole season)",
+					"required":    false,
+				},
 			},
 		},
 	}
@@ -448,9 +460,30 @@ func (h *RosterHandler) HandleAnalyzeRosterStrength(ctx context.Context, args ma
 	}
 	rosterID := int(rosterIDFloat)
 
+	mode, _ := args["mode"].(string)
+	if mode == "" {
+		mode = StrengthModeDepth
+	}
+	if mode != StrengthModeDepth && mode != StrengthModeProduction {
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Type: "text",
+					Text: fmt.Sprintf("Error: mode must be %s or %s", StrengthModeDepth, StrengthModeProduction),
+				},
+			},
+		}, nil
+	}
+	weeks := 0
+	if weeksFloat, ok := args["weeks"].(float64); ok && weeksFloat > 0 {
+		weeks = int(weeksFloat)
+	}
+
 	h.logger.WithFields(logrus.Fields{
 		"league_id": leagueID,
 		"roster_id": rosterID,
+		"mode":      mode,
 	}).Info("Analyzing roster strength")
 
 	// Get roster data using the existing get_roster logic
@@ -531,8 +564,47 @@ func (h *RosterHandler) HandleAnalyzeRosterStrength(ctx context.Context, args ma
 		}, nil
 	}
 
+	// Depth requirements come from the league's lineup, falling back to a standard lineup
+	var rosterPositions []string
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league info, assuming a standard lineup")
+	} else {
+		rosterPositions = league.RosterPositions
+	}
+
 	// Analyze roster strength
-	analysis := analyzeRosterStrength(targetRoster, allPlayers)
+	analysis := analyzeRosterStrength(targetRoster, allPlayers, rosterPositions)
+
+	if league != nil {
+		fromWeek, toWeek := remainingWeeks(league)
+		if schedule := h.schedule.season(league.Season); schedule != nil {
+			analysis.ByeConflicts = findByeConflicts(targetRoster, allPlayers, rosterPositions, schedule, fromWeek, toWeek)
+			for _, conflict := range analysis.ByeConflicts {
+				analysis.Insights = append(analysis.Insights, conflict.Description)
+			}
+		} else if fromWeek <= toWeek {
+			analysis.ScheduleNote = noScheduleNote(league.Season)
+		}
+	}
+
+	if mode == StrengthModeProduction {
+		if league == nil {
+			return &mcp.CallToolResult{
+				IsError: true,
+				Content: []mcp.Content{
+					&mcp.TextContent{
+						Type: "text",
+						Text: fmt.Sprintf("Error getting league info for production mode: %v", err),
+					},
+				},
+			}, nil
+		}
+
+		productionWeeks, weeklyMatchups := h.loadProductionMatchups(leagueID, league, weeks)
+		production := rankRosterProduction(rosters, allPlayers, rosterPositions, leaguePlayerPoints(weeklyMatchups))
+		applyProductionRanks(&analysis, production[rosterID], len(rosters), productionWeeks)
+	}
 	
 	// Build detailed analysis response
 	response := fmt.Sprintf("## Roster Strength Analysis for Team %d\n\n", rosterID)
@@ -549,6 +621,10 @@ func (h *RosterHandler) HandleAnalyzeRosterStrength(ctx context.Context, args ma
 
 	// Overall strength rating
 	response += fmt.Sprintf("### Overall Strength: %s\n\n", analysis.OverallRating)
+	if len(analysis.ProductionWeeks) > 0 {
+		response += fmt.Sprintf("Production ranks use league scoring from weeks %d-%d\n\n",
+			analysis.ProductionWeeks[0], analysis.ProductionWeeks[len(analysis.ProductionWeeks)-1])
+	}
 
 	// Position breakdown
 	response += "### Positional Analysis\n\n"
@@ -556,10 +632,18 @@ func (h *RosterHandler) HandleAnalyzeRosterStrength(ctx context.Context, args ma
 		response += fmt.Sprintf("**%s**\n", posAnalysis.Position)
 		response += fmt.Sprintf("- Starters: %d\n", posAnalysis.StarterCount)
 		response += fmt.Sprintf("- Bench: %d\n", posAnalysis.BenchCount)
+		response += fmt.Sprintf("- Starting Slots: %d\n", posAnalysis.StartingSlots)
+		response += fmt.Sprintf("- Recommended Depth: %d\n", posAnalysis.RecommendedDepth)
 		response += fmt.Sprintf("- Strength: %s\n", posAnalysis.Strength)
 		if posAnalysis.InjuryConcerns > 0 {
 			response += fmt.Sprintf("- Injury Concerns: %d players\n", posAnalysis.InjuryConcerns)
 		}
+		if posAnalysis.Production != nil {
+			response += fmt.Sprintf("- Starter Points: %.1f (%s of %d)\n",
+				posAnalysis.Production.StarterPoints, ordinal(posAnalysis.Production.StarterRank), analysis.TeamCount)
+			response += fmt.Sprintf("- Depth Points: %.1f (%s of %d)\n",
+				posAnalysis.Production.DepthPoints, ordinal(posAnalysis.Production.DepthRank), analysis.TeamCount)
+		}
 		response += "\n"
 	}
 
@@ -570,6 +654,9 @@ func (h *RosterHandler) HandleAnalyzeRosterStrength(ctx context.Context, args ma
 			response += fmt.Sprintf("- %s\n", insight)
 		}
 	}
+	if analysis.ScheduleNote != "" {
+		response += fmt.Sprintf("\n%s\n", analysis.ScheduleNote)
+	}
 
 	return &mcp.CallToolResult{
 		IsError: false,
@@ -740,8 +827,17 @@ func (h *RosterHandler) HandleCompareRosters(ctx context.Context, args map[strin
 	response += fmt.Sprintf("| Starters | %d | %d |\n\n",
 		len(roster1.Starters), len(roster2.Starters))
 
+	// Compare the positions the league's lineup uses, falling back to a standard lineup
+	var rosterPositions []string
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league info, assuming a standard lineup")
+	} else {
+		rosterPositions = league.RosterPositions
+	}
+
 	// Positional breakdown comparison
-	comparison := compareRostersByPosition(roster1, roster2, allPlayers)
+	comparison := compareRostersByPosition(roster1, roster2, allPlayers, rosterPositions)
 	
 	response += "### Positional Comparison\n\n"
 	for _, posComp := range comparison.PositionalComparisons {
@@ -777,18 +873,26 @@ func (h *RosterHandler) HandleCompareRosters(ctx context.Context, args map[strin
 
 // RosterAnalysis represents the result of analyzing a roster's strength
 type RosterAnalysis struct {
-	OverallRating        string                 `json:"overall_rating"`
-	PositionalBreakdown  []PositionalAnalysis   `json:"positional_breakdown"`
-	Insights             []string               `json:"insights"`
+	OverallRating       string               `json:"overall_rating"`
+	PositionalBreakdown []PositionalAnalysis `json:"positional_breakdown"`
+	Insights            []string             `json:"insights"`
+	ProductionWeeks     []int                `json:"production_weeks,omitempty"` // Weeks scored in production mode
+	TeamCount           int                  `json:"team_count,omitempty"`       // Teams ranked against in production mode
+	ByeConflicts        []ByeConflict        `json:"bye_conflicts,omitempty"`    // Remaining weeks, when the NFL schedule is known
+	ScheduleNote        string               `json:"schedule_note,omitempty"`    // Why bye weeks weren't checked
 }
 
 // PositionalAnalysis represents analysis for a specific position group
 type PositionalAnalysis struct {
-	Position        string `json:"position"`
-	StarterCount    int    `json:"starter_count"`
-	BenchCount      int    `json:"bench_count"`
-	Strength        string `json:"strength"`
-	InjuryConcerns  int    `json:"injury_concerns"`
+	Position         string `json:"position"`
+	StarterCount     int    `json:"starter_count"`
+	BenchCount       int    `json:"bench_count"`
+	StartingSlots    int    `json:"starting_slots"`    // Lineup slots only this position can fill
+	RecommendedDepth int    `json:"recommended_depth"` // Players needed to rate Strong
+	Strength         string `json:"strength"`
+	InjuryConcerns   int    `json:"injury_concerns"`
+	// League-wide ranks by points scored, in production mode only
+	Production *PositionalProduction `json:"production,omitempty"`
 }
 
 // RosterComparison represents the result of comparing two rosters
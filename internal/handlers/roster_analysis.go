@@ -2,12 +2,116 @@ package handlers
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
 )
 
-// analyzeRosterStrength performs simple roster strength analysis
-func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper.Player) RosterAnalysis {
+// defaultRosterPositions is a standard lineup, used when a league's roster positions are unknown
+var defaultRosterPositions = []string{"QB", "RB", "RB", "WR", "WR", "TE", "FLEX", "K", "DEF", "BN", "BN", "BN", "BN", "BN", "BN"}
+
+// nonStartingSlots are roster positions that never score
+var nonStartingSlots = map[string]bool{
+	"BN":   true,
+	"IR":   true,
+	"TAXI": true,
+}
+
+// positionRequirement describes how many players a league needs at a position
+type positionRequirement struct {
+	Position      string
+	StartingSlots int     // Slots only this position can fill
+	Starters      float64 // Expected starters, sharing flex slots between eligible positions
+	Adequate      int     // Enough to fill the lineup every week
+	Depth         int     // Enough to cover byes and injuries given the league's bench size
+}
+
+// leaguePositions returns the player positions that can fill a league's starting slots,
+// in the order they first appear
+func leaguePositions(rosterPositions []string) []string {
+	var positions []string
+	seen := make(map[string]bool)
+	for _, slot := range rosterPositions {
+		if nonStartingSlots[slot] {
+			continue
+		}
+		for _, position := range eligiblePositions(slot) {
+			if !seen[position] {
+				seen[position] = true
+				positions = append(positions, position)
+			}
+		}
+	}
+	if len(positions) == 0 {
+		return []string{"QB", "RB", "WR", "TE", "K", "DEF"}
+	}
+	return positions
+}
+
+// rosterRequirements derives depth requirements from a league's starting slots and roster size.
+// SUPER_FLEX is counted as a second quarterback; other flex slots are split evenly between
+// the positions that can fill them.
+func rosterRequirements(rosterPositions []string) []positionRequirement {
+	if len(rosterPositions) == 0 {
+		rosterPositions = defaultRosterPositions
+	}
+
+	startingSlots := make(map[string]int)
+	starters := make(map[string]float64)
+	lineupSize, rosterSize := 0, 0
+	for _, slot := range rosterPositions {
+		switch slot {
+		case "IR", "TAXI":
+			continue
+		case "BN":
+			rosterSize++
+			continue
+		}
+		lineupSize++
+		rosterSize++
+
+		eligible := eligiblePositions(slot)
+		switch {
+		case len(eligible) == 1:
+			startingSlots[eligible[0]]++
+			starters[eligible[0]]++
+		case slot == "SUPER_FLEX":
+			starters["QB"]++
+		default:
+			for _, position := range eligible {
+				starters[position] += 1 / float64(len(eligible))
+			}
+		}
+	}
+
+	benchFactor := 1.0
+	if lineupSize > 0 {
+		benchFactor = float64(rosterSize) / float64(lineupSize)
+	}
+
+	var requirements []positionRequirement
+	for _, position := range leaguePositions(rosterPositions) {
+		adequate := int(math.Round(starters[position]))
+		if startingSlots[position] > adequate {
+			adequate = startingSlots[position]
+		}
+		depth := int(math.Round(starters[position] * benchFactor))
+		if depth <= adequate {
+			depth = adequate + 1
+		}
+		requirements = append(requirements, positionRequirement{
+			Position:      position,
+			StartingSlots: startingSlots[position],
+			Starters:      math.Round(starters[position]*100) / 100,
+			Adequate:      adequate,
+			Depth:         depth,
+		})
+	}
+	return requirements
+}
+
+// analyzeRosterStrength rates each position against the depth the league's lineup requires
+func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper.Player, rosterPositions []string) RosterAnalysis {
 	// Count players by position
 	positionCounts := make(map[string]struct {
 		starters int
@@ -26,20 +130,18 @@ func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper
 			continue
 		}
 		
-		pos := player.Position
-		if pos == "" {
-			pos = "UNKNOWN"
-		}
-		
-		counts := positionCounts[pos]
-		counts.starters++
-		
-		// Check injury status
-		if player.InjuryStatus != "" && player.InjuryStatus != "Healthy" {
-			counts.injured++
+		// Multi-position players add depth at every position they're eligible for
+		for _, pos := range playerPositions(player) {
+			counts := positionCounts[pos]
+			counts.starters++
+			
+			// Check injury status
+			if player.InjuryStatus != "" && player.InjuryStatus != "Healthy" {
+				counts.injured++
+			}
+			
+			positionCounts[pos] = counts
 		}
-		
-		positionCounts[pos] = counts
 	}
 
 	// Analyze bench
@@ -58,69 +160,43 @@ func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper
 			continue
 		}
 		
-		pos := player.Position
-		if pos == "" {
-			pos = "UNKNOWN"
-		}
-		
-		counts := positionCounts[pos]
-		counts.bench++
-		
-		// Check injury status
-		if player.InjuryStatus != "" && player.InjuryStatus != "Healthy" {
-			counts.injured++
+		// Multi-position players add depth at every position they're eligible for
+		for _, pos := range playerPositions(player) {
+			counts := positionCounts[pos]
+			counts.bench++
+			
+			// Check injury status
+			if player.InjuryStatus != "" && player.InjuryStatus != "Healthy" {
+				counts.injured++
+			}
+			
+			positionCounts[pos] = counts
 		}
-		
-		positionCounts[pos] = counts
 	}
 
 	// Build positional analysis
 	var positionalBreakdown []PositionalAnalysis
 	var insights []string
 	
-	positions := []string{"QB", "RB", "WR", "TE", "K", "DEF"}
-	
-	for _, pos := range positions {
+	for _, requirement := range rosterRequirements(rosterPositions) {
+		pos := requirement.Position
 		counts := positionCounts[pos]
 		
-		// Determine strength rating
+		// Determine strength rating against the league's requirements
 		var strength string
 		totalPlayers := counts.starters + counts.bench
 		
-		switch pos {
-		case "QB":
-			if totalPlayers >= 3 {
-				strength = "Strong"
-			} else if totalPlayers >= 2 {
-				strength = "Adequate"
-			} else {
-				strength = "Weak"
-				insights = append(insights, fmt.Sprintf("Consider adding more %s depth", pos))
-			}
-		case "RB", "WR":
-			if totalPlayers >= 5 {
-				strength = "Strong"
-			} else if totalPlayers >= 3 {
-				strength = "Adequate"
-			} else {
-				strength = "Weak"
-				insights = append(insights, fmt.Sprintf("Needs more %s depth", pos))
-			}
-		case "TE":
-			if totalPlayers >= 2 {
-				strength = "Strong"
-			} else if totalPlayers >= 1 {
-				strength = "Adequate"
-			} else {
-				strength = "Weak"
-				insights = append(insights, fmt.Sprintf("Missing %s coverage", pos))
-			}
+		switch {
+		case totalPlayers >= requirement.Depth:
+			strength = "Strong"
+		case totalPlayers >= requirement.Adequate:
+			strength = "Adequate"
+		case totalPlayers < requirement.StartingSlots:
+			strength = "Weak"
+			insights = append(insights, fmt.Sprintf("Not enough %s to fill %d starting slots", pos, requirement.StartingSlots))
 		default:
-			if totalPlayers >= 1 {
-				strength = "Adequate"
-			} else {
-				strength = "Weak"
-			}
+			strength = "Weak"
+			insights = append(insights, fmt.Sprintf("Needs more %s depth (has %d, lineup needs about %d)", pos, totalPlayers, requirement.Adequate))
 		}
 
 		if counts.injured > 0 {
@@ -128,11 +204,13 @@ func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper
 		}
 
 		positionalBreakdown = append(positionalBreakdown, PositionalAnalysis{
-			Position:       pos,
-			StarterCount:   counts.starters,
-			BenchCount:     counts.bench,
-			Strength:       strength,
-			InjuryConcerns: counts.injured,
+			Position:         pos,
+			StarterCount:     counts.starters,
+			BenchCount:       counts.bench,
+			StartingSlots:    requirement.StartingSlots,
+			RecommendedDepth: requirement.Depth,
+			Strength:         strength,
+			InjuryConcerns:   counts.injured,
 		})
 	}
 
@@ -179,10 +257,10 @@ func analyzeRosterStrength(roster *sleeper.Roster, allPlayers map[string]sleeper
 }
 
 // compareRostersByPosition compares two rosters position by position
-func compareRostersByPosition(roster1, roster2 *sleeper.Roster, allPlayers map[string]sleeper.Player) RosterComparison {
+func compareRostersByPosition(roster1, roster2 *sleeper.Roster, allPlayers map[string]sleeper.Player, rosterPositions []string) RosterComparison {
 	// Get positional breakdowns for both teams
-	analysis1 := analyzeRosterStrength(roster1, allPlayers)
-	analysis2 := analyzeRosterStrength(roster2, allPlayers)
+	analysis1 := analyzeRosterStrength(roster1, allPlayers, rosterPositions)
+	analysis2 := analyzeRosterStrength(roster2, allPlayers, rosterPositions)
 
 	var positionalComparisons []PositionalComparison
 	var summary []string
@@ -190,10 +268,8 @@ func compareRostersByPosition(roster1, roster2 *sleeper.Roster, allPlayers map[s
 	team1Advantages := 0
 	team2Advantages := 0
 
-	// Compare each position
-	positions := []string{"QB", "RB", "WR", "TE", "K", "DEF"}
-	
-	for _, pos := range positions {
+	// Compare each position the league starts
+	for _, pos := range leaguePositions(rosterPositions) {
 		var pos1, pos2 PositionalAnalysis
 		
 		// Find position data for each team
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Roster strength modes
+const (
+	StrengthModeDepth      = "depth"      // Headcounts against the league's lineup requirements
+	StrengthModeProduction = "production" // Adds league-wide ranks by fantasy points scored
+)
+
+// PositionalProduction represents the fantasy points a team's players at one position
+// have scored in the league, ranked against every other team
+type PositionalProduction struct {
+	StarterPoints float64 `json:"starter_points"` // Best players up to the number the lineup starts
+	DepthPoints   float64 `json:"depth_points"`   // The next players, up to the recommended depth
+	StarterRank   int     `json:"starter_rank"`   // 1 is the league's best
+	DepthRank     int     `json:"depth_rank"`
+}
+
+// loadProductionMatchups fetches matchups from the league's first week through its last
+// scored week, or only the last N scored weeks when weeks is set. Weeks that fail to load
+// are skipped.
+func (h *RosterHandler) loadProductionMatchups(leagueID string, league *sleeper.League, weeks int) ([]int, map[int][]sleeper.Matchup) {
+	startWeek, _ := regularSeasonWeeks(league)
+	endWeek := league.Settings.LastScoredLeg
+	if weeks > 0 && endWeek-weeks+1 > startWeek {
+		startWeek = endWeek - weeks + 1
+	}
+
+	var scoredWeeks []int
+	weeklyMatchups := make(map[int][]sleeper.Matchup)
+	for week := startWeek; week <= endWeek; week++ {
+		matchups, err := h.client.GetMatchups(leagueID, week)
+		if err != nil {
+			h.logger.WithError(err).WithField("week", week).Warn("Failed to get matchups for week, skipping")
+			continue
+		}
+		scoredWeeks = append(scoredWeeks, week)
+		weeklyMatchups[week] = matchups
+	}
+	return scoredWeeks, weeklyMatchups
+}
+
+// leaguePlayerPoints totals each player's points from a league's matchups, for whichever
+// team rostered them at the time
+func leaguePlayerPoints(weeklyMatchups map[int][]sleeper.Matchup) map[string]float64 {
+	points := make(map[string]float64)
+	for _, matchups := range weeklyMatchups {
+		for _, matchup := range matchups {
+			for playerID, playerPoints := range matchup.PlayersPoints {
+				points[playerID] += playerPoints
+			}
+		}
+	}
+	return points
+}
+
+// rankRosterProduction ranks every roster's starters and depth at each league position by the
+// points its current players have scored. Multi-position players count at each position.
+func rankRosterProduction(rosters []sleeper.Roster, allPlayers map[string]sleeper.Player, rosterPositions []string, playerPoints map[string]float64) map[int]map[string]PositionalProduction {
+	requirements := rosterRequirements(rosterPositions)
+	production := make(map[int]map[string]PositionalProduction)
+
+	for _, roster := range rosters {
+		production[roster.RosterID] = make(map[string]PositionalProduction)
+
+		for _, requirement := range requirements {
+			var points []float64
+			for _, playerID := range roster.Players {
+				player, exists := allPlayers[playerID]
+				if !exists {
+					continue
+				}
+				for _, position := range playerPositions(player) {
+					if position == requirement.Position {
+						points = append(points, playerPoints[playerID])
+						break
+					}
+				}
+			}
+			sort.Sort(sort.Reverse(sort.Float64Slice(points)))
+
+			starters := int(math.Ceil(requirement.Starters))
+			depth := requirement.Depth
+			if depth < starters {
+				depth = starters
+			}
+			var positional PositionalProduction
+			for i, playerPoints := range points {
+				switch {
+				case i < starters:
+					positional.StarterPoints += playerPoints
+				case i < depth:
+					positional.DepthPoints += playerPoints
+				}
+			}
+			positional.StarterPoints = math.Round(positional.StarterPoints*100) / 100
+			positional.DepthPoints = math.Round(positional.DepthPoints*100) / 100
+			production[roster.RosterID][requirement.Position] = positional
+		}
+	}
+
+	// Rank each position across the league; tied teams share a rank
+	for _, requirement := range requirements {
+		position := requirement.Position
+		rank := func(points func(PositionalProduction) float64, assign func(*PositionalProduction, int)) {
+			for rosterID, positions := range production {
+				positional := positions[position]
+				better := 0
+				for otherID, others := range production {
+					if otherID != rosterID && points(others[position]) > points(positional) {
+						better++
+					}
+				}
+				assign(&positional, better+1)
+				positions[position] = positional
+			}
+		}
+		rank(func(p PositionalProduction) float64 { return p.StarterPoints }, func(p *PositionalProduction, r int) { p.StarterRank = r })
+		rank(func(p PositionalProduction) float64 { return p.DepthPoints }, func(p *PositionalProduction, r int) { p.DepthRank = r })
+	}
+
+	return production
+}
+
+// applyProductionRanks attaches a roster's production ranks to its analysis and calls out
+// positions in the top or bottom quarter of the league
+func applyProductionRanks(analysis *RosterAnalysis, production map[string]PositionalProduction, teamCount int, weeks []int) {
+	analysis.ProductionWeeks = weeks
+	analysis.TeamCount = teamCount
+
+	for i := range analysis.PositionalBreakdown {
+		positional := &analysis.PositionalBreakdown[i]
+		ranks, exists := production[positional.Position]
+		if !exists {
+			continue
+		}
+		positional.Production = &ranks
+
+		quarter := int(math.Max(1, math.Floor(float64(teamCount)/4)))
+		switch {
+		case ranks.StarterRank <= quarter:
+			analysis.Insights = append(analysis.Insights, fmt.Sprintf("%s starters rank %s of %d (%.1f points)",
+				positional.Position, ordinal(ranks.StarterRank), teamCount, ranks.StarterPoints))
+		case ranks.StarterRank > teamCount-quarter:
+			analysis.Insights = append(analysis.Insights, fmt.Sprintf("%s starters rank only %s of %d (%.1f points) - an upgrade target",
+				positional.Position, ordinal(ranks.StarterRank), teamCount, ranks.StarterPoints))
+		}
+		if ranks.DepthRank > teamCount-quarter && ranks.StarterRank <= teamCount-quarter {
+			analysis.Insights = append(analysis.Insights, fmt.Sprintf("%s depth ranks %s of %d",
+				positional.Position, ordinal(ranks.DepthRank), teamCount))
+		}
+	}
+}
+
+// ordinal formats a rank as 1st, 2nd, 3rd, 4th and so on
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
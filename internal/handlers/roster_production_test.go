@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// productionRosters, productionPlayers and productionMatchups describe a three-team league
+// with the same matchup every week, shared by the production and positional rankings tests
+var productionRosters = []sleeper.Roster{
+	{RosterID: 1, OwnerID: "user1", Players: []string{"qb1", "wr1", "wr2", "wr3"}},
+	{RosterID: 2, OwnerID: "user2", Players: []string{"qb2", "wr4", "wr5"}},
+	{RosterID: 3, OwnerID: "user3", Players: []string{"qb3", "wr6", "wr7", "wr8"}},
+}
+
+var productionPlayers = map[string]sleeper.Player{
+	"qb1": {PlayerID: "qb1", Position: "QB"},
+	"qb2": {PlayerID: "qb2", Position: "QB"},
+	"qb3": {PlayerID: "qb3", Position: "QB"},
+	"wr1": {PlayerID: "wr1", Position: "WR"},
+	"wr2": {PlayerID: "wr2", Position: "WR"},
+	"wr3": {PlayerID: "wr3", Position: "WR"},
+	"wr4": {PlayerID: "wr4", Position: "WR"},
+	"wr5": {PlayerID: "wr5", Position: "WR"},
+	"wr6": {PlayerID: "wr6", Position: "WR"},
+	"wr7": {PlayerID: "wr7", Position: "WR"},
+	"wr8": {PlayerID: "wr8", Position: "WR"},
+}
+
+// Each week: team 1 WRs 10+8 (bench 2), team 2 WRs 15+12, team 3 WRs 5+4 (bench 9)
+var productionMatchups = []sleeper.Matchup{
+	{RosterID: 1, MatchupID: 1, PlayersPoints: map[string]float64{"qb1": 20, "wr1": 10, "wr2": 8, "wr3": 2}},
+	{RosterID: 2, MatchupID: 1, PlayersPoints: map[string]float64{"qb2": 15, "wr4": 15, "wr5": 12}},
+	{RosterID: 3, MatchupID: 2, PlayersPoints: map[string]float64{"qb3": 25, "wr6": 5, "wr7": 4, "wr8": 9}},
+}
+
+// newProductionMockClient serves the production league through week 3
+func newProductionMockClient() *MockSleeperClient {
+	return &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID:        leagueID,
+				RosterPositions: []string{"QB", "WR", "WR", "BN", "BN"},
+				Settings:        sleeper.LeagueSettings{StartWeek: 1, PlayoffWeekStart: 15, LastScoredLeg: 3},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return productionRosters, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{{UserID: "user1", DisplayName: "Sam"}}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return productionPlayers, nil
+		},
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			return productionMatchups, nil
+		},
+	}
+}
+
+func TestRankRosterProduction(t *testing.T) {
+	production := rankRosterProduction(productionRosters, productionPlayers, []string{"QB", "WR", "WR", "BN", "BN"}, leaguePlayerPoints(map[int][]sleeper.Matchup{1: productionMatchups}))
+
+	tests := []struct {
+		rosterID      int
+		position      string
+		starterPoints float64
+		starterRank   int
+		depthRank     int
+	}{
+		{1, "WR", 18, 2, 2},
+		{2, "WR", 27, 1, 3},
+		{3, "WR", 14, 3, 1}, // Its best two receivers are wr8 (9) and wr6 (5)
+		{3, "QB", 25, 1, 1},
+		{2, "QB", 15, 3, 1}, // No backup quarterbacks anywhere, so depth ties
+	}
+
+	for _, tt := range tests {
+		positional := production[tt.rosterID][tt.position]
+		if positional.StarterPoints != tt.starterPoints || positional.StarterRank != tt.starterRank || positional.DepthRank != tt.depthRank {
+			t.Errorf("Roster %d %s: expected %.1f starter points ranked %d with depth ranked %d, got %+v",
+				tt.rosterID, tt.position, tt.starterPoints, tt.starterRank, tt.depthRank, positional)
+		}
+	}
+}
+
+func TestRosterHandler_HandleAnalyzeRosterStrength_Production(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	var requestedWeeks []int
+	mockClient := newProductionMockClient()
+	getMatchups := mockClient.GetMatchupsFunc
+	mockClient.GetMatchupsFunc = func(leagueID string, week int) ([]sleeper.Matchup, error) {
+		requestedWeeks = append(requestedWeeks, week)
+		return getMatchups(leagueID, week)
+	}
+	handler := NewRosterHandler(mockClient, logger)
+
+	result, err := handler.HandleAnalyzeRosterStrength(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(2),
+		"mode":      "production",
+		"weeks":     float64(2),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected a successful result, got %v", result.Content)
+	}
+	if len(requestedWeeks) != 2 || requestedWeeks[0] != 2 {
+		t.Errorf("Expected weeks 2-3 to be loaded, got %v", requestedWeeks)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, expected := range []string{"weeks 2-3", "Starter Points: 54.0 (1st of 3)", "WR starters rank 1st of 3", "QB starters rank only 3rd of 3"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected analysis to contain %q, got:\n%s", expected, text)
+		}
+	}
+}
+
+func TestRosterHandler_HandleAnalyzeRosterStrength_InvalidMode(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewRosterHandler(&MockSleeperClient{}, logger)
+
+	result, err := handler.HandleAnalyzeRosterStrength(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(1),
+		"mode":      "vibes",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for an unknown mode")
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for n, expected := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd"} {
+		if got := ordinal(n); got != expected {
+			t.Errorf("Expected ordinal(%d) to be %s, got %s", n, expected, got)
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Stash violations flagged by validate_roster_rules
+const (
+	StashViolationReserveIneligible = "reserve_ineligible"
+	StashViolationReserveOverLimit  = "reserve_over_limit"
+	StashViolationTaxiIneligible    = "taxi_ineligible"
+	StashViolationTaxiOverLimit     = "taxi_over_limit"
+)
+
+// StashRules represents a league's IR and taxi squad rules
+type StashRules struct {
+	ReserveSlots            int      `json:"reserve_slots"`
+	ReserveEligibleStatuses []string `json:"reserve_eligible_statuses"` // Injury statuses allowed on IR
+	TaxiSlots               int      `json:"taxi_slots"`
+	TaxiYears               int      `json:"taxi_years"` // Seasons of NFL experience allowed on the taxi squad; 0 is rookies only
+	TaxiAllowVets           bool     `json:"taxi_allow_vets"`
+}
+
+// StashViolation represents a player stashed against the league's rules
+type StashViolation struct {
+	Type         string `json:"type"`
+	PlayerID     string `json:"player_id"`
+	Name         string `json:"name"`
+	Position     string `json:"position"`
+	Team         string `json:"team,omitempty"`
+	InjuryStatus string `json:"injury_status,omitempty"`
+	YearsExp     int    `json:"years_exp"`
+	Detail       string `json:"detail"`
+}
+
+// RosterStashCheck represents one roster's IR and taxi squad check
+type RosterStashCheck struct {
+	RosterID     int              `json:"roster_id"`
+	OwnerName    string           `json:"owner_name,omitempty"`
+	ReserveCount int              `json:"reserve_count"`
+	TaxiCount    int              `json:"taxi_count"`
+	Legal        bool             `json:"legal"`
+	Violations   []StashViolation `json:"violations,omitempty"`
+}
+
+// RosterRulesValidation represents a league-wide IR and taxi squad check
+type RosterRulesValidation struct {
+	LeagueID         string             `json:"league_id"`
+	Season           string             `json:"season"`
+	Rules            StashRules         `json:"rules"`
+	TeamsChecked     int                `json:"teams_checked"`
+	IllegalStashes   int                `json:"illegal_stashes"`
+	TeamsInViolation int                `json:"teams_in_violation"`
+	Rosters          []RosterStashCheck `json:"rosters"` // Teams with the most violations first
+}
+
+// reserveStatusSettings maps injury statuses to the league setting that allows them on IR.
+// IR and PUP are always allowed.
+var reserveStatusSettings = []struct {
+	status  string
+	allowed func(settings sleeper.LeagueSettings) bool
+}{
+	{"IR", func(settings sleeper.LeagueSettings) bool { return true }},
+	{"PUP", func(settings sleeper.LeagueSettings) bool { return true }},
+	{"Out", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowOut == 1 }},
+	{"Doubtful", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowDoubtful == 1 }},
+	{"Sus", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowSus == 1 }},
+	{"COV", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowCov == 1 }},
+	{"NA", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowNA == 1 }},
+	{"DNR", func(settings sleeper.LeagueSettings) bool { return settings.ReserveAllowDNR == 1 }},
+}
+
+// leagueStashRules reads a league's IR and taxi squad rules from its settings
+func leagueStashRules(settings sleeper.LeagueSettings) StashRules {
+	rules := StashRules{
+		ReserveSlots:  settings.ReserveSlots,
+		TaxiSlots:     settings.TaxiSlots,
+		TaxiYears:     settings.TaxiYears,
+		TaxiAllowVets: settings.TaxiAllowVets == 1,
+	}
+	for _, setting := range reserveStatusSettings {
+		if setting.allowed(settings) {
+			rules.ReserveEligibleStatuses = append(rules.ReserveEligibleStatuses, setting.status)
+		}
+	}
+	return rules
+}
+
+// ValidateRosterRulesTool returns the MCP tool definition for validate_roster_rules
+func (h *RosterHandler) ValidateRosterRulesTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "validate_roster_rules",
+		Description: "Check every team's IR and taxi squad against the league's rules: IR players whose injury status the league doesn't allow on IR, taxi players with too much NFL experience, and teams using more IR or taxi slots than allowed. Reports illegal stashes across the league.",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"roster_id": map[string]interface{}{
+					"type":        "integer",
+					"description": "Check only this team (default: every team)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleValidateRosterRules handles the validate_roster_rules tool call
+func (h *RosterHandler) HandleValidateRosterRules(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling validate_roster_rules")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+	rosterID := 0
+	if rosterIDFloat, ok := args["roster_id"].(float64); ok {
+		rosterID = int(rosterIDFloat)
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+	if rosterID != 0 {
+		var selected []sleeper.Roster
+		for _, roster := range rosters {
+			if roster.RosterID == rosterID {
+				selected = append(selected, roster)
+			}
+		}
+		if len(selected) == 0 {
+			return toolErrorResult(fmt.Sprintf("Roster %d not found in league", rosterID)), nil
+		}
+		rosters = selected
+	}
+
+	allPlayers, err := h.client.GetAllPlayers()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get players")
+		return toolErrorResult(fmt.Sprintf("Failed to get players: %s", err.Error())), nil
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without owner names")
+	}
+
+	validation := validateRosterRules(rosters, users, allPlayers, leagueStashRules(league.Settings))
+	validation.LeagueID = leagueID
+	validation.Season = league.Season
+
+	summary := fmt.Sprintf("No illegal IR or taxi stashes across %d teams", validation.TeamsChecked)
+	if validation.IllegalStashes > 0 {
+		var details []string
+		for _, check := range validation.Rosters {
+			for _, violation := range check.Violations {
+				details = append(details, violation.Detail)
+			}
+		}
+		summary = fmt.Sprintf("%d illegal stashes on %d of %d teams: %s",
+			validation.IllegalStashes, validation.TeamsInViolation, validation.TeamsChecked, strings.Join(details, "; "))
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    validation,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: 4,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// validateRosterRules checks each roster's IR and taxi squad against the league's rules
+func validateRosterRules(rosters []sleeper.Roster, users []sleeper.User, allPlayers map[string]sleeper.Player, rules StashRules) *RosterRulesValidation {
+	ownerNames := make(map[string]string)
+	for _, user := range users {
+		ownerNames[user.UserID] = userDisplayName(user)
+	}
+
+	validation := &RosterRulesValidation{
+		Rules:        rules,
+		TeamsChecked: len(rosters),
+		Rosters:      []RosterStashCheck{},
+	}
+
+	for _, roster := range rosters {
+		ownerName := ownerNames[roster.OwnerID]
+		teamName := ownerName
+		if teamName == "" {
+			teamName = fmt.Sprintf("Team %d", roster.RosterID)
+		}
+
+		check := RosterStashCheck{
+			RosterID:     roster.RosterID,
+			OwnerName:    ownerName,
+			ReserveCount: len(roster.Reserve),
+			TaxiCount:    len(roster.Taxi),
+		}
+		check.Violations = append(check.Violations, checkReserve(roster.Reserve, allPlayers, rules, teamName)...)
+		check.Violations = append(check.Violations, checkTaxi(roster.Taxi, allPlayers, rules, teamName)...)
+		check.Legal = len(check.Violations) == 0
+
+		if !check.Legal {
+			validation.TeamsInViolation++
+			validation.IllegalStashes += len(check.Violations)
+		}
+		validation.Rosters = append(validation.Rosters, check)
+	}
+
+	sort.SliceStable(validation.Rosters, func(i, j int) bool {
+		return len(validation.Rosters[i].Violations) > len(validation.Rosters[j].Violations)
+	})
+	return validation
+}
+
+// checkReserve flags IR players whose injury status the league doesn't allow on IR, and
+// the eligible players past the league's IR slot limit. Players missing from the player
+// database can't be checked, so they only count toward the limit.
+func checkReserve(reserve []string, allPlayers map[string]sleeper.Player, rules StashRules, teamName string) []StashViolation {
+	var violations []StashViolation
+	var overLimit []StashViolation
+	eligible := 0
+	for _, playerID := range reserve {
+		player, known := allPlayers[playerID]
+		violation := newStashViolation(playerID, player)
+
+		if known && !containsString(rules.ReserveEligibleStatuses, violation.InjuryStatus) {
+			status := violation.InjuryStatus
+			if status == "" {
+				status = "healthy"
+			}
+			violation.Type = StashViolationReserveIneligible
+			violation.Detail = fmt.Sprintf("%s has %s on IR while %s", teamName, violation.Name, status)
+			violations = append(violations, violation)
+			continue
+		}
+
+		eligible++
+		if eligible > rules.ReserveSlots {
+			violation.Type = StashViolationReserveOverLimit
+			overLimit = append(overLimit, violation)
+		}
+	}
+
+	for _, violation := range overLimit {
+		violation.Detail = fmt.Sprintf("%s has %d eligible players on IR but the league allows %d (%s)",
+			teamName, eligible, rules.ReserveSlots, violation.Name)
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// checkTaxi flags taxi players with too much NFL experience, and the eligible players
+// past the league's taxi slot limit. Like IR, unknown players only count toward the limit.
+func checkTaxi(taxi []string, allPlayers map[string]sleeper.Player, rules StashRules, teamName string) []StashViolation {
+	var violations []StashViolation
+	var overLimit []StashViolation
+	eligible := 0
+	for _, playerID := range taxi {
+		player, known := allPlayers[playerID]
+		violation := newStashViolation(playerID, player)
+
+		if known && !rules.TaxiAllowVets && violation.YearsExp > rules.TaxiYears {
+			violation.Type = StashViolationTaxiIneligible
+			violation.Detail = fmt.Sprintf("%s has %s on the taxi squad in NFL season %d (limit: season %d)",
+				teamName, violation.Name, violation.YearsExp+1, rules.TaxiYears+1)
+			violations = append(violations, violation)
+			continue
+		}
+
+		eligible++
+		if eligible > rules.TaxiSlots {
+			violation.Type = StashViolationTaxiOverLimit
+			overLimit = append(overLimit, violation)
+		}
+	}
+
+	for _, violation := range overLimit {
+		violation.Detail = fmt.Sprintf("%s has %d eligible players on the taxi squad but the league allows %d (%s)",
+			teamName, eligible, rules.TaxiSlots, violation.Name)
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// newStashViolation builds a violation's view of a stashed player
+func newStashViolation(playerID string, player sleeper.Player) StashViolation {
+	name := playerName(player)
+	if name == "" {
+		name = playerID
+	}
+	return StashViolation{
+		PlayerID:     playerID,
+		Name:         name,
+		Position:     player.Position,
+		Team:         player.Team,
+		InjuryStatus: player.InjuryStatus,
+		YearsExp:     player.YearsExp,
+	}
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLeagueStashRules(t *testing.T) {
+	rules := leagueStashRules(sleeper.LeagueSettings{
+		ReserveSlots:    2,
+		ReserveAllowOut: 1,
+		ReserveAllowCov: 1,
+		TaxiSlots:       3,
+		TaxiYears:       2,
+	})
+
+	expected := []string{"IR", "PUP", "Out", "COV"}
+	if len(rules.ReserveEligibleStatuses) != len(expected) {
+		t.Fatalf("Expected IR statuses %v, got %v", expected, rules.ReserveEligibleStatuses)
+	}
+	for i, status := range expected {
+		if rules.ReserveEligibleStatuses[i] != status {
+			t.Errorf("Expected IR status %d to be %s, got %s", i, status, rules.ReserveEligibleStatuses[i])
+		}
+	}
+	if rules.ReserveSlots != 2 || rules.TaxiSlots != 3 || rules.TaxiYears != 2 || rules.TaxiAllowVets {
+		t.Errorf("Unexpected slot rules: %+v", rules)
+	}
+}
+
+func TestValidateRosterRules(t *testing.T) {
+	players := map[string]sleeper.Player{
+		"ir1":   {PlayerID: "ir1", FullName: "Nick Chubb", Position: "RB", InjuryStatus: "IR"},
+		"ir2":   {PlayerID: "ir2", FullName: "Davante Adams", Position: "WR", InjuryStatus: "Out"},
+		"ir3":   {PlayerID: "ir3", FullName: "Josh Allen", Position: "QB"},
+		"ir4":   {PlayerID: "ir4", FullName: "Mark Andrews", Position: "TE", InjuryStatus: "Questionable"},
+		"taxi1": {PlayerID: "taxi1", FullName: "Rookie One", Position: "WR", YearsExp: 0},
+		"taxi2": {PlayerID: "taxi2", FullName: "Second Year", Position: "RB", YearsExp: 1},
+		"taxi3": {PlayerID: "taxi3", FullName: "Old Vet", Position: "TE", YearsExp: 6},
+		"taxi4": {PlayerID: "taxi4", FullName: "Rookie Two", Position: "QB", YearsExp: 0},
+		"ir5":   {PlayerID: "ir5", FullName: "Kyler Murray", Position: "QB", InjuryStatus: "PUP"},
+	}
+	rosters := []sleeper.Roster{
+		{RosterID: 1, OwnerID: "user1", Reserve: []string{"ir1", "ir2"}, Taxi: []string{"taxi1"}},
+		{RosterID: 2, OwnerID: "user2", Reserve: []string{"ir3", "ir1", "ir4", "ir2", "ir5"}, Taxi: []string{"taxi2", "taxi3", "taxi4"}},
+	}
+	users := []sleeper.User{{UserID: "user1", DisplayName: "Sam"}, {UserID: "user2", DisplayName: "Alex"}}
+	rules := leagueStashRules(sleeper.LeagueSettings{ReserveSlots: 2, ReserveAllowOut: 1, TaxiSlots: 1, TaxiYears: 2})
+
+	validation := validateRosterRules(rosters, users, players, rules)
+
+	if validation.TeamsChecked != 2 || validation.TeamsInViolation != 1 || validation.IllegalStashes != 5 {
+		t.Fatalf("Expected 5 illegal stashes on 1 of 2 teams, got %+v", validation)
+	}
+
+	alex := validation.Rosters[0]
+	if alex.RosterID != 2 || alex.Legal || alex.ReserveCount != 5 || alex.TaxiCount != 3 {
+		t.Fatalf("Expected Alex's roster listed first and illegal, got %+v", alex)
+	}
+	expected := []struct {
+		violationType string
+		playerID      string
+		detail        string
+	}{
+		{StashViolationReserveIneligible, "ir3", "Alex has Josh Allen on IR while healthy"},
+		{StashViolationReserveIneligible, "ir4", "Alex has Mark Andrews on IR while Questionable"},
+		// Only eligible players count toward the slot limit
+		{StashViolationReserveOverLimit, "ir5", "Alex has 3 eligible players on IR but the league allows 2 (Kyler Murray)"},
+		{StashViolationTaxiIneligible, "taxi3", "Alex has Old Vet on the taxi squad in NFL season 7 (limit: season 3)"},
+		{StashViolationTaxiOverLimit, "taxi4", "Alex has 2 eligible players on the taxi squad but the league allows 1 (Rookie Two)"},
+	}
+	for i, want := range expected {
+		violation := alex.Violations[i]
+		if violation.Type != want.violationType || violation.PlayerID != want.playerID || violation.Detail != want.detail {
+			t.Errorf("Violation %d: expected %s for %s (%q), got %+v", i, want.violationType, want.playerID, want.detail, violation)
+		}
+	}
+
+	if sam := validation.Rosters[1]; !sam.Legal || len(sam.Violations) != 0 {
+		t.Errorf("Expected Sam's IR and taxi squad to be legal, got %+v", sam)
+	}
+
+	// Too much experience for the taxi squad unless the league allows veterans
+	rosters[1].Taxi = []string{"taxi3"}
+	validation = validateRosterRules(rosters[1:], users, players, rules)
+	if violations := validation.Rosters[0].Violations; violations[len(violations)-1].Type != StashViolationTaxiIneligible {
+		t.Errorf("Expected a 6-year veteran to be taxi ineligible, got %+v", violations)
+	}
+	rules.TaxiAllowVets = true
+	validation = validateRosterRules(rosters[1:], users, players, rules)
+	for _, violation := range validation.Rosters[0].Violations {
+		if violation.Type == StashViolationTaxiIneligible {
+			t.Errorf("Expected veterans allowed on the taxi squad, got %+v", violation)
+		}
+	}
+
+	// Players missing from the player database aren't reported as healthy on IR
+	unknown := []sleeper.Roster{{RosterID: 1, OwnerID: "user1", Reserve: []string{"retired1"}}}
+	if violations := validateRosterRules(unknown, users, players, rules).Rosters[0].Violations; len(violations) != 0 {
+		t.Errorf("Expected no violations for an unknown IR player, got %+v", violations)
+	}
+
+	// A taxi_years of 0 allows rookies only
+	rules = leagueStashRules(sleeper.LeagueSettings{TaxiSlots: 2})
+	taxiOnly := []sleeper.Roster{{RosterID: 1, OwnerID: "user1", Taxi: []string{"taxi1", "taxi2"}}}
+	violations := validateRosterRules(taxiOnly, users, players, rules).Rosters[0].Violations
+	if len(violations) != 1 || violations[0].PlayerID != "taxi2" || violations[0].Type != StashViolationTaxiIneligible ||
+		violations[0].Detail != "Sam has Second Year on the taxi squad in NFL season 2 (limit: season 1)" {
+		t.Errorf("Expected only the second-year player flagged in a rookies-only league, got %+v", violations)
+	}
+}
+
+func TestRosterHandler_HandleValidateRosterRules(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	client := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID: leagueID,
+				Season:   "2024",
+				Settings: sleeper.LeagueSettings{ReserveSlots: 1, TaxiSlots: 2},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, OwnerID: "user1", Reserve: []string{"p1"}, Taxi: []string{"p2"}},
+				{RosterID: 2, OwnerID: "user2", Reserve: []string{}, Taxi: []string{}},
+			}, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{{UserID: "user1", DisplayName: "Sam"}}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"p1": {PlayerID: "p1", FullName: "Christian McCaffrey", Position: "RB", InjuryStatus: "Out"},
+				"p2": {PlayerID: "p2", FullName: "Jayden Reed", Position: "WR", YearsExp: 1},
+			}, nil
+		},
+	}
+	handler := NewRosterHandler(client, logger)
+
+	result, err := handler.HandleValidateRosterRules(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected text content in result")
+	}
+
+	var response struct {
+		Success bool                  `json:"success"`
+		Data    RosterRulesValidation `json:"data"`
+		Summary string                `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if response.Data.IllegalStashes != 2 {
+		t.Fatalf("Expected 2 illegal stashes, got %+v", response.Data)
+	}
+	expectedSummary := "2 illegal stashes on 1 of 2 teams: Sam has Christian McCaffrey on IR while Out; " +
+		"Sam has Jayden Reed on the taxi squad in NFL season 2 (limit: season 1)"
+	if response.Summary != expectedSummary {
+		t.Errorf("Unexpected summary: %s", response.Summary)
+	}
+
+	result, err = handler.HandleValidateRosterRules(context.Background(), map[string]interface{}{
+		"league_id": "league1",
+		"roster_id": float64(9),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for an unknown roster")
+	}
+}
@@ -660,4 +660,111 @@ func TestRosterHandler_HandleCompareRosters(t *testing.T) {
 	if len(result.Content) == 0 {
 		t.Error("Expected content in result")
 	}
-}
\ No newline at end of file
+}
+
+func TestAnalyzeRosterStrength_UsesLeagueRosterPositions(t *testing.T) {
+	roster := &sleeper.Roster{
+		RosterID: 1,
+		Players:  []string{"qb1", "qb2", "rb1", "rb2", "rb3", "wr1", "wr2", "wr3", "te1"},
+		Starters: []string{"qb1", "rb1", "rb2", "wr1", "wr2", "te1"},
+	}
+	players := map[string]sleeper.Player{
+		"qb1": {PlayerID: "qb1", Position: "QB"},
+		"qb2": {PlayerID: "qb2", Position: "QB"},
+		"rb1": {PlayerID: "rb1", Position: "RB"},
+		"rb2": {PlayerID: "rb2", Position: "RB"},
+		"rb3": {PlayerID: "rb3", Position: "RB"},
+		"wr1": {PlayerID: "wr1", Position: "WR"},
+		"wr2": {PlayerID: "wr2", Position: "WR"},
+		"wr3": {PlayerID: "wr3", Position: "WR"},
+		"te1": {PlayerID: "te1", Position: "TE"},
+	}
+	bench := []string{"BN", "BN", "BN", "BN", "BN", "BN"}
+	standard := append([]string{"QB", "RB", "RB", "WR", "WR", "TE", "FLEX"}, bench...)
+	superflex := append([]string{"QB", "RB", "RB", "WR", "WR", "TE", "FLEX", "SUPER_FLEX"}, bench...)
+
+	strength := func(analysis RosterAnalysis, position string) PositionalAnalysis {
+		for _, positional := range analysis.PositionalBreakdown {
+			if positional.Position == position {
+				return positional
+			}
+		}
+		t.Fatalf("Expected %s in the positional breakdown", position)
+		return PositionalAnalysis{}
+	}
+
+	standardAnalysis := analyzeRosterStrength(roster, players, standard)
+	superflexAnalysis := analyzeRosterStrength(roster, players, superflex)
+
+	if qb := strength(standardAnalysis, "QB"); qb.Strength != "Strong" || qb.StartingSlots != 1 {
+		t.Errorf("Expected two quarterbacks to be Strong in a 1QB league, got %+v", qb)
+	}
+	if qb := strength(superflexAnalysis, "QB"); qb.Strength != "Adequate" || qb.RecommendedDepth != 4 {
+		t.Errorf("Expected two quarterbacks to be only Adequate in a superflex league, got %+v", qb)
+	}
+
+	// Leagues without kickers or defenses aren't rated on them
+	for _, positional := range standardAnalysis.PositionalBreakdown {
+		if positional.Position == "K" || positional.Position == "DEF" {
+			t.Errorf("Expected no %s rating in a league without that slot", positional.Position)
+		}
+	}
+
+	// Without roster positions a standard lineup with a kicker and defense is assumed
+	defaultAnalysis := analyzeRosterStrength(roster, players, nil)
+	if k := strength(defaultAnalysis, "K"); k.Strength != "Weak" || k.StartingSlots != 1 {
+		t.Errorf("Expected a missing kicker to be Weak in a standard lineup, got %+v", k)
+	}
+}
+
+func TestAnalyzeRosterStrength_IDP(t *testing.T) {
+	rosterPositions := []string{"QB", "RB", "WR", "DL", "LB", "LB", "DB", "IDP_FLEX", "BN", "BN", "BN", "BN"}
+	players := map[string]sleeper.Player{
+		"qb1": {PlayerID: "qb1", Position: "QB"},
+		"rb1": {PlayerID: "rb1", Position: "RB"},
+		"wr1": {PlayerID: "wr1", Position: "WR"},
+		"de1": {PlayerID: "de1", Position: "DE"},
+		"lb1": {PlayerID: "lb1", Position: "LB", FantasyPositions: []string{"LB"}},
+		"lb2": {PlayerID: "lb2", Position: "ILB"},
+		"hy1": {PlayerID: "hy1", Position: "LB", FantasyPositions: []string{"DL", "LB"}},
+		"cb1": {PlayerID: "cb1", Position: "CB"},
+		"s1":  {PlayerID: "s1", Position: "S"},
+	}
+	roster1 := &sleeper.Roster{
+		RosterID: 1,
+		Players:  []string{"qb1", "rb1", "wr1", "de1", "lb1", "lb2", "hy1", "cb1"},
+		Starters: []string{"qb1", "rb1", "wr1", "de1", "lb1", "lb2", "cb1", "hy1"},
+	}
+	roster2 := &sleeper.Roster{
+		RosterID: 2,
+		Players:  []string{"s1"},
+		Starters: []string{"s1"},
+	}
+
+	analysis := analyzeRosterStrength(roster1, players, rosterPositions)
+
+	counts := make(map[string]int)
+	for _, positional := range analysis.PositionalBreakdown {
+		counts[positional.Position] = positional.StarterCount + positional.BenchCount
+		if positional.Position == "UNKNOWN" {
+			t.Error("Expected IDP players to be counted at their positions, not UNKNOWN")
+		}
+	}
+
+	// The DL/LB hybrid counts at both positions
+	expected := map[string]int{"DL": 2, "LB": 3, "DB": 1}
+	for position, count := range expected {
+		if counts[position] != count {
+			t.Errorf("Expected %d players at %s, got %d", count, position, counts[position])
+		}
+	}
+
+	comparison := compareRostersByPosition(roster1, roster2, players, rosterPositions)
+	advantages := make(map[string]string)
+	for _, positional := range comparison.PositionalComparisons {
+		advantages[positional.Position] = positional.Advantage
+	}
+	if advantages["LB"] != "Team 1" || advantages["DB"] != "Even" {
+		t.Errorf("Expected team 1 to lead at LB and DB to be even, got %v", advantages)
+	}
+}
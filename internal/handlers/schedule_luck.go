@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// ScheduleOutcome represents a team's record when playing another team's schedule
+type ScheduleOutcome struct {
+	RosterID    int    `json:"roster_id"` // Whose schedule was played
+	DisplayName string `json:"display_name"`
+	Wins        int    `json:"wins"`
+	Losses      int    `json:"losses"`
+	Ties        int    `json:"ties"`
+	Record      string `json:"record"`
+}
+
+// ScheduleLuckTeam represents one team's results across every schedule in the league
+type ScheduleLuckTeam struct {
+	RosterID      int             `json:"roster_id"`
+	OwnerID       string          `json:"owner_id"`
+	DisplayName   string          `json:"display_name"`
+	ActualRecord  string          `json:"actual_record"`
+	AverageWins   float64         `json:"average_wins"` // Mean wins across all schedules, including their own
+	Luck          float64         `json:"luck"`         // Actual wins minus average wins
+	BestSchedule  ScheduleOutcome `json:"best_schedule"`
+	WorstSchedule ScheduleOutcome `json:"worst_schedule"`
+}
+
+// ScheduleLuck represents every team's record against every other team's schedule
+type ScheduleLuck struct {
+	LeagueID  string `json:"league_id"`
+	StartWeek int    `json:"start_week"`
+	EndWeek   int    `json:"end_week"`
+	// Matrix[i][j] is team i's W-L-T playing team j's schedule; the diagonal is the actual record
+	Teams    []string           `json:"teams"` // Row and column labels for the matrix
+	Matrix   [][]string         `json:"matrix"`
+	Rankings []ScheduleLuckTeam `json:"rankings"` // Luckiest first
+	Warnings []string           `json:"warnings,omitempty"`
+}
+
+// GetScheduleLuckTool returns the MCP tool definition for get_schedule_luck
+func (h *LeagueHandler) GetScheduleLuckTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_schedule_luck",
+		Description: "Recompute each team's record as if it had played every other team's schedule, returning an N×N matrix of alternative records plus each team's best and worst case schedules and how lucky its actual schedule was",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"through_week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Last week to include (default: last scored regular season week)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetScheduleLuck handles the get_schedule_luck tool call
+func (h *LeagueHandler) HandleGetScheduleLuck(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_schedule_luck")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	throughWeek := 0
+	if weekFloat, ok := args["through_week"].(float64); ok {
+		throughWeek = int(weekFloat)
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without display names")
+	}
+
+	records, startWeek, endWeek, warnings, apiCalls := h.recomputeRecordsFromMatchups(leagueID, league, throughWeek)
+
+	luck := buildScheduleLuck(records, rosters, users)
+	luck.LeagueID = leagueID
+	luck.StartWeek = startWeek
+	luck.EndWeek = endWeek
+	luck.Warnings = warnings
+
+	summary := fmt.Sprintf("Schedule luck for %d teams over weeks %d-%d", len(luck.Rankings), startWeek, endWeek)
+	if len(luck.Rankings) > 1 {
+		luckiest, unluckiest := luck.Rankings[0], luck.Rankings[len(luck.Rankings)-1]
+		summary += fmt.Sprintf(": luckiest %s (%+.1f wins), unluckiest %s (%+.1f wins)",
+			luckiest.DisplayName, luckiest.Luck, unluckiest.DisplayName, unluckiest.Luck)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    luck,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls + 3,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildScheduleLuck plays each team's weekly scores against every other team's
+// opponents. When a team would face itself on another schedule, it plays that
+// schedule's owner instead. League median results stay with the team's own score.
+func buildScheduleLuck(records map[int]*RecomputedRecord, rosters []sleeper.Roster, users []sleeper.User) *ScheduleLuck {
+	luck := &ScheduleLuck{}
+
+	names := make(map[string]string)
+	for _, user := range users {
+		names[user.UserID] = userDisplayName(user)
+	}
+	owners := make(map[int]string)
+	for _, roster := range rosters {
+		owners[roster.RosterID] = roster.OwnerID
+	}
+
+	rosterIDs := make([]int, 0, len(records))
+	for rosterID := range records {
+		rosterIDs = append(rosterIDs, rosterID)
+	}
+	sort.Ints(rosterIDs)
+
+	// Weekly results keyed by roster, then week
+	weekly := make(map[int]map[int]WeeklyTeamResult)
+	for rosterID, record := range records {
+		weekly[rosterID] = make(map[int]WeeklyTeamResult)
+		for _, week := range record.Weeks {
+			weekly[rosterID][week.Week] = week
+		}
+	}
+
+	displayName := func(rosterID int) string {
+		if name := names[owners[rosterID]]; name != "" {
+			return name
+		}
+		return fmt.Sprintf("Roster %d", rosterID)
+	}
+
+	for _, team := range rosterIDs {
+		luck.Teams = append(luck.Teams, displayName(team))
+
+		var row []string
+		var outcomes []ScheduleOutcome
+		for _, scheduleOf := range rosterIDs {
+			outcome := ScheduleOutcome{RosterID: scheduleOf, DisplayName: displayName(scheduleOf)}
+
+			for _, week := range records[team].Weeks {
+				results := []string{week.MedianResult}
+
+				schedule, played := weekly[scheduleOf][week.Week]
+				if played && schedule.OpponentRosterID != 0 {
+					opponent := schedule.OpponentRosterID
+					if opponent == team {
+						opponent = scheduleOf
+					}
+					if opponentWeek, exists := weekly[opponent][week.Week]; exists {
+						results = append(results, gameResult(week.PointsFor, opponentWeek.PointsFor))
+					}
+				}
+
+				for _, result := range results {
+					switch result {
+					case "W":
+						outcome.Wins++
+					case "L":
+						outcome.Losses++
+					case "T":
+						outcome.Ties++
+					}
+				}
+			}
+
+			outcome.Record = fmt.Sprintf("%d-%d-%d", outcome.Wins, outcome.Losses, outcome.Ties)
+			row = append(row, outcome.Record)
+			outcomes = append(outcomes, outcome)
+		}
+		luck.Matrix = append(luck.Matrix, row)
+
+		actual := records[team]
+		ranking := ScheduleLuckTeam{
+			RosterID:      team,
+			OwnerID:       owners[team],
+			DisplayName:   displayName(team),
+			ActualRecord:  fmt.Sprintf("%d-%d-%d", actual.Wins, actual.Losses, actual.Ties),
+			BestSchedule:  outcomes[0],
+			WorstSchedule: outcomes[0],
+		}
+		totalWins := 0.0
+		for _, outcome := range outcomes {
+			wins := scheduleWins(outcome)
+			totalWins += wins
+			if wins > scheduleWins(ranking.BestSchedule) {
+				ranking.BestSchedule = outcome
+			}
+			if wins < scheduleWins(ranking.WorstSchedule) {
+				ranking.WorstSchedule = outcome
+			}
+		}
+		ranking.AverageWins = math.Round(totalWins/float64(len(outcomes))*100) / 100
+		ranking.Luck = math.Round((float64(actual.Wins)+float64(actual.Ties)*0.5-ranking.AverageWins)*100) / 100
+		luck.Rankings = append(luck.Rankings, ranking)
+	}
+
+	sort.SliceStable(luck.Rankings, func(i, j int) bool {
+		return luck.Rankings[i].Luck > luck.Rankings[j].Luck
+	})
+
+	return luck
+}
+
+// scheduleWins counts a tie as half a win
+func scheduleWins(outcome ScheduleOutcome) float64 {
+	return float64(outcome.Wins) + float64(outcome.Ties)*0.5
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+func TestBuildScheduleLuck(t *testing.T) {
+	// Week 1: 1 (100) beats 2 (90), 4 (70) beats 3 (60)
+	// Week 2: 1 (80) loses to 3 (85), 4 (110) beats 2 (50)
+	week := func(week, opponent int, points, opponentPoints float64) WeeklyTeamResult {
+		return WeeklyTeamResult{Week: week, OpponentRosterID: opponent, PointsFor: points, Result: gameResult(points, opponentPoints)}
+	}
+	records := map[int]*RecomputedRecord{
+		1: {RosterID: 1, Wins: 1, Losses: 1, Weeks: []WeeklyTeamResult{week(1, 2, 100, 90), week(2, 3, 80, 85)}},
+		2: {RosterID: 2, Losses: 2, Weeks: []WeeklyTeamResult{week(1, 1, 90, 100), week(2, 4, 50, 110)}},
+		3: {RosterID: 3, Wins: 1, Losses: 1, Weeks: []WeeklyTeamResult{week(1, 4, 60, 70), week(2, 1, 85, 80)}},
+		4: {RosterID: 4, Wins: 2, Weeks: []WeeklyTeamResult{week(1, 3, 70, 60), week(2, 2, 110, 50)}},
+	}
+	rosters := []sleeper.Roster{{RosterID: 1, OwnerID: "user1"}, {RosterID: 2, OwnerID: "user2"}, {RosterID: 3, OwnerID: "user3"}, {RosterID: 4, OwnerID: "user4"}}
+	users := []sleeper.User{{UserID: "user1", DisplayName: "One"}, {UserID: "user4", DisplayName: "Four"}}
+
+	luck := buildScheduleLuck(records, rosters, users)
+
+	if len(luck.Matrix) != 4 || len(luck.Matrix[0]) != 4 {
+		t.Fatalf("Expected a 4x4 matrix, got %d rows", len(luck.Matrix))
+	}
+	for i, actual := range []string{"1-1-0", "0-2-0", "1-1-0", "2-0-0"} {
+		if luck.Matrix[i][i] != actual {
+			t.Errorf("Expected the diagonal to hold team %d's actual record %s, got %s", i+1, actual, luck.Matrix[i][i])
+		}
+	}
+
+	// Team 1 on team 4's schedule faces 3 (60) then 2 (50)
+	if luck.Matrix[0][3] != "2-0-0" {
+		t.Errorf("Expected team 1 to go 2-0-0 on team 4's schedule, got %s", luck.Matrix[0][3])
+	}
+	// Team 4 on team 1's schedule faces 2 (90) then 3 (85)
+	if luck.Matrix[3][0] != "1-1-0" {
+		t.Errorf("Expected team 4 to go 1-1-0 on team 1's schedule, got %s", luck.Matrix[3][0])
+	}
+	// Team 2 on team 1's schedule would face itself in week 1, so it plays team 1 (100) instead
+	if luck.Matrix[1][0] != "0-2-0" {
+		t.Errorf("Expected team 2 to go 0-2-0 on team 1's schedule, got %s", luck.Matrix[1][0])
+	}
+
+	if luck.Teams[0] != "One" || luck.Teams[1] != "Roster 2" {
+		t.Errorf("Expected display names with a roster fallback, got %v", luck.Teams)
+	}
+	luckiest := luck.Rankings[0]
+	if luckiest.RosterID != 4 || luckiest.WorstSchedule.RosterID != 1 {
+		t.Errorf("Expected team 4 to be luckiest with team 1's schedule as its worst, got %+v", luckiest)
+	}
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"math"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// scorePlayerStats applies a league's scoring settings to a player's stat line.
+// Stat keys without a scoring value (e.g. Sleeper's precomputed "pts_ppr") are ignored.
+func scorePlayerStats(stats sleeper.PlayerStats, scoringSettings map[string]float64) float64 {
+	points := 0.0
+	for stat, value := range stats {
+		if multiplier, exists := scoringSettings[stat]; exists {
+			points += value * multiplier
+		}
+	}
+
+	return math.Round(points*100) / 100
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus"
+)
+
+// Game types for historical games
+const (
+	GameTypeRegular     = "regular"
+	GameTypePlayoff     = "playoff"     // Winners bracket game
+	GameTypeConsolation = "consolation" // Any other playoff-week game
+)
+
+// SeasonGame represents one team's result in a single head-to-head game
+type SeasonGame struct {
+	Season           string  `json:"season"`
+	LeagueID         string  `json:"league_id"`
+	Week             int     `json:"week"`
+	GameType         string  `json:"game_type"`
+	RosterID         int     `json:"roster_id"`
+	OwnerID          string  `json:"owner_id"`
+	OpponentRosterID int     `json:"opponent_roster_id"`
+	OpponentOwnerID  string  `json:"opponent_owner_id"`
+	PointsFor        float64 `json:"points_for"`
+	PointsAgainst    float64 `json:"points_against"`
+	Result           string  `json:"result"` // "W", "L", "T"
+}
+
+// seasonGameLog holds every head-to-head game played in one season
+type seasonGameLog struct {
+	Season   string
+	LeagueID string
+	League   *sleeper.League
+	Owners   map[int]string // Roster ID to owner ID
+	Games    []SeasonGame   // One entry per team per game, ordered by week then roster
+}
+
+// leagueGameHistory holds the game logs for every discovered season, oldest first
+type leagueGameHistory struct {
+	Name     string
+	Seasons  []*seasonGameLog
+	Owners   *ownerRegistry
+	Warnings []string
+	APICalls int
+}
+
+// loadLeagueGameHistory discovers a league's seasons and loads every season's games
+func (h *LeagueHandler) loadLeagueGameHistory(leagueID string) (*leagueGameHistory, error) {
+	leagueGroup, err := h.discoverLeagueHistory(leagueID, 10)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, warnings, apiCalls := h.loadOwnerRegistry(leagueGroup, leagueID)
+	history := &leagueGameHistory{
+		Name:     leagueGroup.Name,
+		Owners:   registry,
+		Warnings: warnings,
+		APICalls: calculateAPICalls(leagueGroup) + apiCalls,
+	}
+
+	seasons := make([]string, 0, len(leagueGroup.Seasons))
+	for season := range leagueGroup.Seasons {
+		seasons = append(seasons, season)
+	}
+	sort.Strings(seasons)
+
+	for _, season := range seasons {
+		gameLog, warnings, apiCalls := h.loadSeasonGames(leagueGroup.Seasons[season], registry)
+		history.APICalls += apiCalls
+		history.Warnings = append(history.Warnings, warnings...)
+		history.Seasons = append(history.Seasons, gameLog)
+	}
+
+	return history, nil
+}
+
+// loadSeasonGames fetches a season's bracket and weekly matchups and pairs them into
+// games, attributing each roster to its registry owner. Weeks are scanned until the
+// first week without scores.
+func (h *LeagueHandler) loadSeasonGames(seasonData SeasonData, registry *ownerRegistry) (*seasonGameLog, []string, int) {
+	gameLog := &seasonGameLog{
+		Season:   seasonData.Season,
+		LeagueID: seasonData.LeagueID,
+		League:   seasonData.League,
+		Owners:   make(map[int]string),
+	}
+	var warnings []string
+	apiCalls := 0
+
+	// Seasons whose rosters failed to load were already reported by the registry
+	if _, loaded := registry.seasonRosters[seasonData.Season]; !loaded {
+		return gameLog, warnings, apiCalls
+	}
+	for rosterID, ownerID := range registry.rosterOwners[seasonData.Season] {
+		gameLog.Owners[rosterID] = ownerID
+	}
+
+	// Winners bracket pairings identify which playoff-week games are real playoff games
+	bracketPairs := make(map[[2]int]bool)
+	winnersBracket, err := h.client.GetWinnersBracket(seasonData.LeagueID)
+	apiCalls++
+	if err != nil {
+		h.logger.WithError(err).WithField("season", seasonData.Season).Debug("No winners bracket for season")
+	}
+	for _, game := range winnersBracket {
+		bracketPairs[[2]int{game.Team1, game.Team2}] = true
+		bracketPairs[[2]int{game.Team2, game.Team1}] = true
+	}
+
+	startWeek, regularEnd := regularSeasonWeeks(seasonData.League)
+	lastWeek := 18
+	if seasonData.League != nil && seasonData.League.Settings.LastScoredLeg > 0 {
+		lastWeek = seasonData.League.Settings.LastScoredLeg
+	}
+
+	for week := startWeek; week <= lastWeek; week++ {
+		matchups, err := h.client.GetMatchups(seasonData.LeagueID, week)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithFields(logrus.Fields{
+				"season": seasonData.Season,
+				"week":   week,
+			}).Warn("Failed to get matchups for week")
+			warnings = append(warnings, fmt.Sprintf("%s week %d: failed to get matchups (%s)", seasonData.Season, week, err.Error()))
+			continue
+		}
+		if !weekHasScores(matchups) {
+			break
+		}
+
+		var weekGames []SeasonGame
+		for _, pair := range groupMatchupsByID(matchups) {
+			if len(pair) != 2 {
+				continue
+			}
+
+			gameType := GameTypeRegular
+			if week > regularEnd {
+				gameType = GameTypeConsolation
+				if bracketPairs[[2]int{pair[0].RosterID, pair[1].RosterID}] {
+					gameType = GameTypePlayoff
+				}
+			}
+
+			for i, team := range pair {
+				opponent := pair[1-i]
+				points, opponentPoints := matchupPoints(team), matchupPoints(opponent)
+				weekGames = append(weekGames, SeasonGame{
+					Season:           seasonData.Season,
+					LeagueID:         seasonData.LeagueID,
+					Week:             week,
+					GameType:         gameType,
+					RosterID:         team.RosterID,
+					OwnerID:          gameLog.Owners[team.RosterID],
+					OpponentRosterID: opponent.RosterID,
+					OpponentOwnerID:  gameLog.Owners[opponent.RosterID],
+					PointsFor:        points,
+					PointsAgainst:    opponentPoints,
+					Result:           gameResult(points, opponentPoints),
+				})
+			}
+		}
+
+		sort.Slice(weekGames, func(i, j int) bool {
+			return weekGames[i].RosterID < weekGames[j].RosterID
+		})
+		gameLog.Games = append(gameLog.Games, weekGames...)
+	}
+
+	return gameLog, warnings, apiCalls
+}
+
+// ownerNames maps owner IDs to their most recent display name across seasons
+func (history *leagueGameHistory) ownerNames() map[string]string {
+	return history.Owners.names()
+}
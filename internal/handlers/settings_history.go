@@ -0,0 +1,320 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// Settings change categories
+const (
+	SettingsCategoryScoring         = "scoring"
+	SettingsCategoryRosterPositions = "roster_positions"
+	SettingsCategoryLeague          = "league_settings"
+)
+
+// seasonProgressSettings change every week rather than by rule, so they are not diffed
+var seasonProgressSettings = map[string]bool{
+	"leg":             true,
+	"last_scored_leg": true,
+}
+
+// SettingChange represents one rule that differs between two seasons
+type SettingChange struct {
+	Category    string `json:"category"` // "scoring", "roster_positions", "league_settings"
+	Setting     string `json:"setting"`
+	From        string `json:"from,omitempty"` // Empty when the setting was added
+	To          string `json:"to,omitempty"`   // Empty when the setting was removed
+	Description string `json:"description"`
+}
+
+// SeasonSettingsDiff represents every rule change from one season to the next
+type SeasonSettingsDiff struct {
+	FromSeason   string          `json:"from_season"`
+	ToSeason     string          `json:"to_season"`
+	FromLeagueID string          `json:"from_league_id"`
+	ToLeagueID   string          `json:"to_league_id"`
+	Changes      []SettingChange `json:"changes"`
+}
+
+// SettingsHistory represents rule changes across a league's history
+type SettingsHistory struct {
+	LeagueName   string               `json:"league_name"`
+	Seasons      []string             `json:"seasons"`
+	TotalChanges int                  `json:"total_changes"`
+	Diffs        []SeasonSettingsDiff `json:"diffs"`
+}
+
+// GetSettingsHistoryTool returns the MCP tool definition for get_settings_history
+func (h *LeagueHandler) GetSettingsHistoryTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "get_settings_history",
+		Description: "Diff scoring settings, roster positions and league settings between consecutive seasons to list every rule change (e.g. \"rec changed 0.5 -> 1\" or \"added SUPER_FLEX\"), for context when comparing seasons",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Current league ID (will auto-discover historical seasons)",
+					"required":    true,
+				},
+			},
+		},
+	}
+}
+
+// HandleGetSettingsHistory handles the get_settings_history tool call
+func (h *LeagueHandler) HandleGetSettingsHistory(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling get_settings_history")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	leagueGroup, err := h.discoverLeagueHistory(leagueID, 10)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to discover league history")
+		return toolErrorResult(fmt.Sprintf("Failed to discover league history: %s", err.Error())), nil
+	}
+
+	seasons := make([]string, 0, len(leagueGroup.Seasons))
+	for season := range leagueGroup.Seasons {
+		seasons = append(seasons, season)
+	}
+	sort.Strings(seasons)
+
+	leagues := make([]*sleeper.League, 0, len(seasons))
+	for _, season := range seasons {
+		leagues = append(leagues, leagueGroup.Seasons[season].League)
+	}
+
+	history := &SettingsHistory{
+		LeagueName: leagueGroup.Name,
+		Seasons:    seasons,
+		Diffs:      diffSeasonSettings(leagues),
+	}
+	for _, diff := range history.Diffs {
+		history.TotalChanges += len(diff.Changes)
+	}
+
+	summary := fmt.Sprintf("%d rule changes across %d seasons", history.TotalChanges, len(seasons))
+	if len(seasons) > 0 {
+		summary += fmt.Sprintf(" (%s to %s)", seasons[0], seasons[len(seasons)-1])
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    history,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: calculateAPICalls(leagueGroup),
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// diffSeasonSettings diffs each season's league against the one before it. Leagues
+// must be ordered oldest first; missing leagues are skipped.
+func diffSeasonSettings(leagues []*sleeper.League) []SeasonSettingsDiff {
+	var diffs []SeasonSettingsDiff
+	var previous *sleeper.League
+	for _, league := range leagues {
+		if league == nil {
+			continue
+		}
+		if previous != nil {
+			diffs = append(diffs, SeasonSettingsDiff{
+				FromSeason:   previous.Season,
+				ToSeason:     league.Season,
+				FromLeagueID: previous.LeagueID,
+				ToLeagueID:   league.LeagueID,
+				Changes:      diffLeagueSettings(previous, league),
+			})
+		}
+		previous = league
+	}
+	return diffs
+}
+
+// diffLeagueSettings lists every scoring, roster position and league setting change
+// between two seasons
+func diffLeagueSettings(previous, current *sleeper.League) []SettingChange {
+	var changes []SettingChange
+	changes = append(changes, diffScoringSettings(previous.ScoringSettings, current.ScoringSettings)...)
+	changes = append(changes, diffRosterPositions(previous.RosterPositions, current.RosterPositions)...)
+	changes = append(changes, diffRawSettings(leagueSettingsMap(previous), leagueSettingsMap(current))...)
+	return changes
+}
+
+// leagueSettingsMap returns a league's settings as Sleeper sent them, falling back to
+// the typed settings for leagues that weren't decoded from the API
+func leagueSettingsMap(league *sleeper.League) map[string]interface{} {
+	if league.RawSettings != nil {
+		return league.RawSettings
+	}
+
+	settings := make(map[string]interface{})
+	data, err := json.Marshal(league.Settings)
+	if err == nil {
+		err = json.Unmarshal(data, &settings)
+	}
+	if err != nil {
+		return nil
+	}
+	return settings
+}
+
+// diffRawSettings compares every league setting, including ones added or removed
+func diffRawSettings(previous, current map[string]interface{}) []SettingChange {
+	settings := make(map[string]bool)
+	for setting := range previous {
+		settings[setting] = true
+	}
+	for setting := range current {
+		settings[setting] = true
+	}
+	sortedSettings := make([]string, 0, len(settings))
+	for setting := range settings {
+		if !seasonProgressSettings[setting] {
+			sortedSettings = append(sortedSettings, setting)
+		}
+	}
+	sort.Strings(sortedSettings)
+
+	var changes []SettingChange
+	for _, setting := range sortedSettings {
+		fromValue, hadSetting := previous[setting]
+		toValue, hasSetting := current[setting]
+		from, to := fmt.Sprint(fromValue), fmt.Sprint(toValue)
+		if hadSetting == hasSetting && from == to {
+			continue
+		}
+
+		change := SettingChange{Category: SettingsCategoryLeague, Setting: setting}
+		switch {
+		case !hadSetting:
+			change.To = to
+			change.Description = fmt.Sprintf("%s added at %s", setting, to)
+		case !hasSetting:
+			change.From = from
+			change.Description = fmt.Sprintf("%s removed (was %s)", setting, from)
+		default:
+			change.From, change.To = from, to
+			change.Description = fmt.Sprintf("%s changed %s -> %s", setting, from, to)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// diffScoringSettings compares scoring values, reporting stats added or removed even
+// when they are worth 0
+func diffScoringSettings(previous, current map[string]float64) []SettingChange {
+	stats := make(map[string]bool)
+	for stat := range previous {
+		stats[stat] = true
+	}
+	for stat := range current {
+		stats[stat] = true
+	}
+	sortedStats := make([]string, 0, len(stats))
+	for stat := range stats {
+		sortedStats = append(sortedStats, stat)
+	}
+	sort.Strings(sortedStats)
+
+	var changes []SettingChange
+	for _, stat := range sortedStats {
+		from, hadStat := previous[stat]
+		to, hasStat := current[stat]
+		if hadStat == hasStat && from == to {
+			continue
+		}
+
+		change := SettingChange{Category: SettingsCategoryScoring, Setting: stat}
+		switch {
+		case !hadStat:
+			change.To = formatSettingValue(to)
+			change.Description = fmt.Sprintf("%s added at %s", stat, change.To)
+		case !hasStat:
+			change.From = formatSettingValue(from)
+			change.Description = fmt.Sprintf("%s removed (was %s)", stat, change.From)
+		default:
+			change.From, change.To = formatSettingValue(from), formatSettingValue(to)
+			change.Description = fmt.Sprintf("%s changed %s -> %s", stat, change.From, change.To)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// diffRosterPositions compares how many of each starting and bench slot a league has
+func diffRosterPositions(previous, current []string) []SettingChange {
+	count := func(positions []string) map[string]int {
+		counts := make(map[string]int)
+		for _, position := range positions {
+			counts[position]++
+		}
+		return counts
+	}
+	previousCounts, currentCounts := count(previous), count(current)
+
+	slots := make(map[string]bool)
+	for slot := range previousCounts {
+		slots[slot] = true
+	}
+	for slot := range currentCounts {
+		slots[slot] = true
+	}
+	sortedSlots := make([]string, 0, len(slots))
+	for slot := range slots {
+		sortedSlots = append(sortedSlots, slot)
+	}
+	sort.Strings(sortedSlots)
+
+	var changes []SettingChange
+	for _, slot := range sortedSlots {
+		from, to := previousCounts[slot], currentCounts[slot]
+		if from == to {
+			continue
+		}
+
+		change := SettingChange{Category: SettingsCategoryRosterPositions, Setting: slot}
+		switch {
+		case from == 0:
+			change.To = strconv.Itoa(to)
+			change.Description = fmt.Sprintf("added %s", slot)
+			if to > 1 {
+				change.Description = fmt.Sprintf("added %d %s", to, slot)
+			}
+		case to == 0:
+			change.From = strconv.Itoa(from)
+			change.Description = fmt.Sprintf("removed %s", slot)
+			if from > 1 {
+				change.Description = fmt.Sprintf("removed %d %s", from, slot)
+			}
+		default:
+			change.From, change.To = strconv.Itoa(from), strconv.Itoa(to)
+			change.Description = fmt.Sprintf("%s slots changed %d -> %d", slot, from, to)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// formatSettingValue formats a scoring value without trailing zeros
+func formatSettingValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
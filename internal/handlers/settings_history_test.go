@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+func TestDiffSeasonSettings(t *testing.T) {
+	leagues := []*sleeper.League{
+		{
+			LeagueID:        "league2022",
+			Season:          "2022",
+			ScoringSettings: map[string]float64{"rec": 0.5, "pass_td": 4, "fum": -1},
+			RosterPositions: []string{"QB", "RB", "RB", "WR", "WR", "FLEX", "K", "BN"},
+			Settings:        sleeper.LeagueSettings{PlayoffTeams: 6, LastScoredLeg: 17},
+		},
+		nil, // A season whose league failed to load is skipped
+		{
+			LeagueID:        "league2023",
+			Season:          "2023",
+			ScoringSettings: map[string]float64{"rec": 1, "pass_td": 4, "bonus_rec_te": 0.5, "pass_int_td": 0},
+			RosterPositions: []string{"QB", "RB", "RB", "WR", "WR", "WR", "FLEX", "SUPER_FLEX", "BN"},
+			Settings:        sleeper.LeagueSettings{PlayoffTeams: 4, LastScoredLeg: 5},
+		},
+	}
+
+	diffs := diffSeasonSettings(leagues)
+	if len(diffs) != 1 || diffs[0].FromSeason != "2022" || diffs[0].ToSeason != "2023" {
+		t.Fatalf("Expected one 2022 -> 2023 diff, got %+v", diffs)
+	}
+
+	var descriptions []string
+	for _, change := range diffs[0].Changes {
+		descriptions = append(descriptions, change.Description)
+	}
+	expected := []string{
+		"bonus_rec_te added at 0.5",
+		"fum removed (was -1)",
+		"pass_int_td added at 0",
+		"rec changed 0.5 -> 1",
+		"removed K",
+		"added SUPER_FLEX",
+		"WR slots changed 2 -> 3",
+		"playoff_teams changed 6 -> 4",
+	}
+	if len(descriptions) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d: %v", len(expected), len(descriptions), descriptions)
+	}
+	for i, description := range expected {
+		if descriptions[i] != description {
+			t.Errorf("Expected change %d to be %q, got %q", i, description, descriptions[i])
+		}
+	}
+}
+
+func TestDiffLeagueSettings_RawSettings(t *testing.T) {
+	var previous, current sleeper.League
+	if err := json.Unmarshal([]byte(`{"season": "2023", "settings": {"playoff_teams": 6, "waiver_budget": 100, "leg": 17}}`), &previous); err != nil {
+		t.Fatalf("Failed to decode league: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"season": "2024", "settings": {"playoff_teams": 6, "waiver_budget": 200, "bench_lock": 1, "leg": 3}}`), &current); err != nil {
+		t.Fatalf("Failed to decode league: %v", err)
+	}
+	if current.Settings.PlayoffTeams != 6 {
+		t.Errorf("Expected typed settings to still decode, got %+v", current.Settings)
+	}
+
+	// Settings the typed struct doesn't model are diffed; weekly progress is not
+	var descriptions []string
+	for _, change := range diffLeagueSettings(&previous, &current) {
+		descriptions = append(descriptions, change.Description)
+	}
+	expected := []string{"bench_lock added at 1", "waiver_budget changed 100 -> 200"}
+	if len(descriptions) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d: %v", len(expected), len(descriptions), descriptions)
+	}
+	for i, description := range expected {
+		if descriptions[i] != description {
+			t.Errorf("Expected change %d to be %q, got %q", i, description, descriptions[i])
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus"
+)
+
+// pointsTolerance is the largest points difference treated as rounding rather than drift
+const pointsTolerance = 0.01
+
+// WeeklyTeamResult represents one team's recomputed result for a single week
+type WeeklyTeamResult struct {
+	Week                int     `json:"week"`
+	OpponentRosterID    int     `json:"opponent_roster_id,omitempty"`
+	PointsFor           float64 `json:"points_for"`
+	PointsAgainst       float64 `json:"points_against"`
+	Result              string  `json:"result"`                        // "W", "L", "T", or "" without an opponent
+	MedianResult        string  `json:"median_result,omitempty"`       // Result against the league median, when enabled
+	CumulativeRecord    string  `json:"cumulative_record"`             // W-L-T after this week
+	CumulativePointsFor float64 `json:"cumulative_points_for"`
+}
+
+// RecomputedRecord represents a team's record rebuilt from weekly matchups
+type RecomputedRecord struct {
+	RosterID      int                `json:"roster_id"`
+	Wins          int                `json:"wins"`
+	Losses        int                `json:"losses"`
+	Ties          int                `json:"ties"`
+	PointsFor     float64            `json:"points_for"`
+	PointsAgainst float64            `json:"points_against"`
+	Weeks         []WeeklyTeamResult `json:"weeks"`
+}
+
+// StandingsDiscrepancy represents a difference between roster totals and recomputed totals
+type StandingsDiscrepancy struct {
+	Field      string  `json:"field"`
+	Reported   float64 `json:"reported"`
+	Recomputed float64 `json:"recomputed"`
+	Difference float64 `json:"difference"`
+}
+
+// TeamAudit represents the audit result for a single team
+type TeamAudit struct {
+	RosterID      int                    `json:"roster_id"`
+	OwnerID       string                 `json:"owner_id"`
+	DisplayName   string                 `json:"display_name"`
+	Reported      RecomputedRecord       `json:"reported"`
+	Recomputed    RecomputedRecord       `json:"recomputed"`
+	Discrepancies []StandingsDiscrepancy `json:"discrepancies,omitempty"`
+}
+
+// StandingsAudit represents the full standings reconciliation report
+type StandingsAudit struct {
+	LeagueID         string      `json:"league_id"`
+	StartWeek        int         `json:"start_week"`
+	EndWeek          int         `json:"end_week"`
+	MedianScoring    bool        `json:"median_scoring"`
+	TotalsCompared   bool        `json:"totals_compared"` // False when through_week stops before the last scored week
+	DiscrepancyCount int         `json:"discrepancy_count"`
+	Teams            []TeamAudit `json:"teams"`
+	Warnings         []string    `json:"warnings,omitempty"`
+}
+
+// AuditStandingsTool returns the MCP tool definition for audit_standings
+func (h *LeagueHandler) AuditStandingsTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "audit_standings",
+		Description: "Recompute each team's W/L/T and points for/against from weekly matchups and report discrepancies against Sleeper's roster totals, week by week",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"through_week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Last week to include (default: last scored regular season week). Roster totals cover the season so far, so they are only compared when this reaches the last scored week",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleAuditStandings handles the audit_standings tool call
+func (h *LeagueHandler) HandleAuditStandings(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling audit_standings")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	throughWeek := 0
+	if weekFloat, ok := args["through_week"].(float64); ok {
+		throughWeek = int(weekFloat)
+	}
+
+	league, err := h.client.GetLeague(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league info")
+		return toolErrorResult(fmt.Sprintf("Failed to get league information: %s", err.Error())), nil
+	}
+
+	rosters, err := h.client.GetLeagueRosters(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get league rosters")
+		return toolErrorResult(fmt.Sprintf("Failed to get league rosters: %s", err.Error())), nil
+	}
+
+	users, err := h.client.GetLeagueUsers(leagueID)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get league users, continuing without display names")
+	}
+
+	audit, apiCalls := h.auditStandings(leagueID, league, rosters, users, throughWeek)
+
+	summary := fmt.Sprintf("Audited %d teams over weeks %d-%d: no discrepancies found", len(audit.Teams), audit.StartWeek, audit.EndWeek)
+	if !audit.TotalsCompared {
+		summary = fmt.Sprintf("Recomputed %d teams over weeks %d-%d; roster totals cover later weeks, so they were not compared", len(audit.Teams), audit.StartWeek, audit.EndWeek)
+	} else if audit.DiscrepancyCount > 0 {
+		summary = fmt.Sprintf("Audited %d teams over weeks %d-%d: %d discrepancies between roster totals and weekly matchups", len(audit.Teams), audit.StartWeek, audit.EndWeek, audit.DiscrepancyCount)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    audit,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls + 3,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// auditStandings compares roster totals against records recomputed from matchups.
+// Roster totals cover every scored week, so they are only compared when throughWeek
+// doesn't stop the recomputation early.
+func (h *LeagueHandler) auditStandings(leagueID string, league *sleeper.League, rosters []sleeper.Roster, users []sleeper.User, throughWeek int) (*StandingsAudit, int) {
+	records, startWeek, endWeek, warnings, apiCalls := h.recomputeRecordsFromMatchups(leagueID, league, throughWeek)
+
+	audit := &StandingsAudit{
+		LeagueID:       leagueID,
+		StartWeek:      startWeek,
+		EndWeek:        endWeek,
+		MedianScoring:  league != nil && league.Settings.LeagueAverageMatch == 1,
+		TotalsCompared: throughWeek <= 0 || throughWeek >= lastScoredRegularSeasonWeek(league),
+		Warnings:       warnings,
+	}
+
+	userMap := make(map[string]string)
+	for _, user := range users {
+		userMap[user.UserID] = user.DisplayName
+	}
+
+	for _, roster := range rosters {
+		reported := RecomputedRecord{
+			RosterID:      roster.RosterID,
+			Wins:          roster.Settings.Wins,
+			Losses:        roster.Settings.Losses,
+			Ties:          roster.Settings.Ties,
+			PointsFor:     rosterPointsFor(roster.Settings),
+			PointsAgainst: rosterPointsAgainst(roster.Settings),
+		}
+
+		recomputed := RecomputedRecord{RosterID: roster.RosterID}
+		if record, exists := records[roster.RosterID]; exists {
+			recomputed = *record
+		}
+
+		teamAudit := TeamAudit{
+			RosterID:    roster.RosterID,
+			OwnerID:     roster.OwnerID,
+			DisplayName: userMap[roster.OwnerID],
+			Reported:    reported,
+			Recomputed:  recomputed,
+		}
+
+		checks := []struct {
+			field      string
+			reported   float64
+			recomputed float64
+		}{
+			{"wins", float64(reported.Wins), float64(recomputed.Wins)},
+			{"losses", float64(reported.Losses), float64(recomputed.Losses)},
+			{"ties", float64(reported.Ties), float64(recomputed.Ties)},
+			{"points_for", reported.PointsFor, recomputed.PointsFor},
+			{"points_against", reported.PointsAgainst, recomputed.PointsAgainst},
+		}
+		for _, check := range checks {
+			if !audit.TotalsCompared {
+				break
+			}
+			difference := check.reported - check.recomputed
+			if math.Abs(difference) > pointsTolerance {
+				teamAudit.Discrepancies = append(teamAudit.Discrepancies, StandingsDiscrepancy{
+					Field:      check.field,
+					Reported:   check.reported,
+					Recomputed: check.recomputed,
+					Difference: math.Round(difference*100) / 100,
+				})
+			}
+		}
+
+		audit.DiscrepancyCount += len(teamAudit.Discrepancies)
+		audit.Teams = append(audit.Teams, teamAudit)
+	}
+
+	sort.Slice(audit.Teams, func(i, j int) bool {
+		return audit.Teams[i].RosterID < audit.Teams[j].RosterID
+	})
+
+	return audit, apiCalls
+}
+
+// lastScoredRegularSeasonWeek returns the last regular season week with scores, or the
+// last regular season week when the league doesn't report one
+func lastScoredRegularSeasonWeek(league *sleeper.League) int {
+	_, endWeek := regularSeasonWeeks(league)
+	if league != nil && league.Settings.LastScoredLeg > 0 && league.Settings.LastScoredLeg < endWeek {
+		return league.Settings.LastScoredLeg
+	}
+	return endWeek
+}
+
+// recomputeRecordsFromMatchups rebuilds every team's regular season record from weekly
+// matchups. It stops at the last scored week so unplayed weeks are not counted as ties.
+func (h *LeagueHandler) recomputeRecordsFromMatchups(leagueID string, league *sleeper.League, throughWeek int) (map[int]*RecomputedRecord, int, int, []string, int) {
+	records := make(map[int]*RecomputedRecord)
+	var warnings []string
+	apiCalls := 0
+
+	startWeek, _ := regularSeasonWeeks(league)
+	endWeek := lastScoredRegularSeasonWeek(league)
+	if throughWeek > 0 && throughWeek < endWeek {
+		endWeek = throughWeek
+	}
+	medianScoring := league != nil && league.Settings.LeagueAverageMatch == 1
+
+	lastWeek := startWeek - 1
+	for week := startWeek; week <= endWeek; week++ {
+		matchups, err := h.client.GetMatchups(leagueID, week)
+		apiCalls++
+		if err != nil {
+			h.logger.WithError(err).WithField("week", week).Warn("Failed to get matchups for week")
+			warnings = append(warnings, fmt.Sprintf("Week %d: failed to get matchups (%s)", week, err.Error()))
+			continue
+		}
+
+		if !weekHasScores(matchups) {
+			h.logger.WithField("week", week).Debug("No scores recorded for week, stopping recomputation")
+			break
+		}
+		lastWeek = week
+
+		warnings = append(warnings, tallyWeek(records, week, matchups, matchupPoints, medianScoring)...)
+	}
+
+	for _, record := range records {
+		record.PointsFor = math.Round(record.PointsFor*100) / 100
+		record.PointsAgainst = math.Round(record.PointsAgainst*100) / 100
+	}
+
+	return records, startWeek, lastWeek, warnings, apiCalls
+}
+
+// tallyWeek adds one week's head-to-head (and median) results to each team's record,
+// scoring each team with points. It returns warnings for incomplete matchups.
+func tallyWeek(records map[int]*RecomputedRecord, week int, matchups []sleeper.Matchup, points func(sleeper.Matchup) float64, medianScoring bool) []string {
+	var warnings []string
+
+	weekResults := make(map[int]*WeeklyTeamResult)
+	for _, matchup := range matchups {
+		weekResults[matchup.RosterID] = &WeeklyTeamResult{
+			Week:      week,
+			PointsFor: points(matchup),
+		}
+	}
+
+	for matchupID, pair := range groupMatchupsByID(matchups) {
+		if len(pair) != 2 {
+			warnings = append(warnings, fmt.Sprintf("Week %d: matchup %d has %d teams", week, matchupID, len(pair)))
+			continue
+		}
+
+		team1, team2 := weekResults[pair[0].RosterID], weekResults[pair[1].RosterID]
+		team1.OpponentRosterID, team2.OpponentRosterID = pair[1].RosterID, pair[0].RosterID
+		team1.PointsAgainst, team2.PointsAgainst = team2.PointsFor, team1.PointsFor
+		team1.Result, team2.Result = gameResult(team1.PointsFor, team2.PointsFor), gameResult(team2.PointsFor, team1.PointsFor)
+	}
+
+	if medianScoring {
+		median := medianScore(weekResults)
+		for _, result := range weekResults {
+			result.MedianResult = gameResult(result.PointsFor, median)
+		}
+	}
+
+	rosterIDs := make([]int, 0, len(weekResults))
+	for rosterID := range weekResults {
+		rosterIDs = append(rosterIDs, rosterID)
+	}
+	sort.Ints(rosterIDs)
+
+	for _, rosterID := range rosterIDs {
+		result := weekResults[rosterID]
+		record := records[rosterID]
+		if record == nil {
+			record = &RecomputedRecord{RosterID: rosterID}
+			records[rosterID] = record
+		}
+		record.PointsFor += result.PointsFor
+		record.PointsAgainst += result.PointsAgainst
+
+		for _, outcome := range []string{result.Result, result.MedianResult} {
+			switch outcome {
+			case "W":
+				record.Wins++
+			case "L":
+				record.Losses++
+			case "T":
+				record.Ties++
+			}
+		}
+
+		result.CumulativeRecord = fmt.Sprintf("%d-%d-%d", record.Wins, record.Losses, record.Ties)
+		result.CumulativePointsFor = math.Round(record.PointsFor*100) / 100
+		record.Weeks = append(record.Weeks, *result)
+	}
+
+	return warnings
+}
+
+// weekHasScores reports whether any team scored in a week's matchups
+func weekHasScores(matchups []sleeper.Matchup) bool {
+	for _, matchup := range matchups {
+		if matchupPoints(matchup) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// gameResult returns "W", "L" or "T" for a score against an opposing score
+func gameResult(points, opponentPoints float64) string {
+	switch {
+	case points > opponentPoints:
+		return "W"
+	case points < opponentPoints:
+		return "L"
+	default:
+		return "T"
+	}
+}
+
+// medianScore returns the median of a week's scores
+func medianScore(weekResults map[int]*WeeklyTeamResult) float64 {
+	scores := make([]float64, 0, len(weekResults))
+	for _, result := range weekResults {
+		scores = append(scores, result.PointsFor)
+	}
+	if len(scores) == 0 {
+		return 0
+	}
+
+	sort.Float64s(scores)
+	middle := len(scores) / 2
+	if len(scores)%2 == 0 {
+		return (scores[middle-1] + scores[middle]) / 2
+	}
+	return scores[middle]
+}
+
+// applyRecomputedRecords replaces standings totals with records rebuilt from matchups
+func applyRecomputedRecords(standings []StandingEntry, records map[int]*RecomputedRecord) {
+	for i := range standings {
+		record, exists := records[standings[i].RosterID]
+		if !exists {
+			continue
+		}
+		standings[i].Wins = record.Wins
+		standings[i].Losses = record.Losses
+		standings[i].Ties = record.Ties
+		standings[i].PointsFor = record.PointsFor
+		standings[i].PointsAgainst = record.PointsAgainst
+	}
+}
+
+// logAuditWarnings logs any warnings raised while recomputing records
+func (h *LeagueHandler) logAuditWarnings(leagueID string, warnings []string) {
+	for _, warning := range warnings {
+		h.logger.WithFields(logrus.Fields{
+			"league_id": leagueID,
+		}).Warn(warning)
+	}
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLeagueHandler_AuditStandingsTool(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(&MockSleeperClient{}, logger)
+
+	tool := handler.AuditStandingsTool()
+
+	if tool.Name != "audit_standings" {
+		t.Errorf("Expected tool name 'audit_standings', got '%s'", tool.Name)
+	}
+
+	if tool.Description == "" {
+		t.Error("Expected tool description to be set")
+	}
+}
+
+func TestLeagueHandler_AuditStandings(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	override := 90.0
+	weeklyMatchups := map[int][]sleeper.Matchup{
+		1: {
+			{RosterID: 1, MatchupID: 1, Points: 110.52},
+			{RosterID: 2, MatchupID: 1, Points: 100.25},
+		},
+		2: {
+			// Commissioner edited roster 1's score down to 90
+			{RosterID: 1, MatchupID: 1, Points: 120.00, CustomPoints: &override},
+			{RosterID: 2, MatchupID: 1, Points: 95.00},
+		},
+		3: {
+			// Not played yet
+			{RosterID: 1, MatchupID: 1},
+			{RosterID: 2, MatchupID: 1},
+		},
+	}
+
+	mockClient := &MockSleeperClient{
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			return weeklyMatchups[week], nil
+		},
+	}
+	handler := NewLeagueHandler(mockClient, logger)
+
+	league := &sleeper.League{Settings: sleeper.LeagueSettings{PlayoffWeekStart: 15}}
+	rosters := []sleeper.Roster{
+		{
+			RosterID: 1,
+			Settings: sleeper.RosterSettings{Wins: 2, FPTS: 230, FPTSDecimal: 52, FPTSAgainst: 195, FPTSAgainstDecimal: 25},
+		},
+		{
+			RosterID: 2,
+			Settings: sleeper.RosterSettings{Losses: 2, FPTS: 195, FPTSDecimal: 25, FPTSAgainst: 230, FPTSAgainstDecimal: 52},
+		},
+	}
+
+	audit, _ := handler.auditStandings("test123", league, rosters, nil, 0)
+
+	if audit.EndWeek != 2 {
+		t.Errorf("Expected audit to stop after week 2, got end week %d", audit.EndWeek)
+	}
+
+	team1 := audit.Teams[0]
+	if team1.Recomputed.Wins != 1 || team1.Recomputed.Losses != 1 {
+		t.Errorf("Expected roster 1 recomputed at 1-1, got %d-%d", team1.Recomputed.Wins, team1.Recomputed.Losses)
+	}
+	if team1.Recomputed.PointsFor != 200.52 {
+		t.Errorf("Expected roster 1 recomputed points for 200.52, got %.2f", team1.Recomputed.PointsFor)
+	}
+	if len(team1.Recomputed.Weeks) != 2 || team1.Recomputed.Weeks[1].CumulativeRecord != "1-1-0" {
+		t.Errorf("Expected week-by-week results ending 1-1-0, got %+v", team1.Recomputed.Weeks)
+	}
+
+	// Wins, losses and points for all drifted for roster 1
+	fields := make(map[string]bool)
+	for _, discrepancy := range team1.Discrepancies {
+		fields[discrepancy.Field] = true
+	}
+	for _, field := range []string{"wins", "losses", "points_for"} {
+		if !fields[field] {
+			t.Errorf("Expected a %s discrepancy for roster 1, got %+v", field, team1.Discrepancies)
+		}
+	}
+	if fields["points_against"] {
+		t.Errorf("Did not expect a points_against discrepancy once fpts_decimal is included, got %+v", team1.Discrepancies)
+	}
+	if !audit.TotalsCompared {
+		t.Error("Expected roster totals to be compared through the last scored week")
+	}
+
+	// Roster totals include week 2, so a week 1 audit can't compare them
+	audit, _ = handler.auditStandings("test123", league, rosters, nil, 1)
+	if audit.EndWeek != 1 || audit.TotalsCompared || audit.DiscrepancyCount != 0 {
+		t.Errorf("Expected an uncompared week 1 audit, got end week %d compared %v with %d discrepancies",
+			audit.EndWeek, audit.TotalsCompared, audit.DiscrepancyCount)
+	}
+	if team1 := audit.Teams[0]; team1.Recomputed.Wins != 1 || len(team1.Discrepancies) != 0 {
+		t.Errorf("Expected roster 1 recomputed at 1-0 with no discrepancies, got %+v", team1)
+	}
+}
+
+func TestLeagueHandler_HandleAuditStandings_MissingLeagueID(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	handler := NewLeagueHandler(&MockSleeperClient{}, logger)
+
+	_, err := handler.HandleAuditStandings(context.Background(), map[string]interface{}{})
+
+	if err == nil {
+		t.Error("Expected error for missing league_id")
+	}
+}
@@ -3,6 +3,10 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus"
 )
 
 // formatJSONResponse converts a response struct to a formatted JSON string
@@ -13,4 +17,84 @@ func formatJSONResponse(response interface{}) (string, error) {
 	}
 	
 	return string(jsonBytes), nil
-}
\ No newline at end of file
+}
+
+// toolErrorResult wraps an error message as an MCP error result
+func toolErrorResult(message string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Type: "text",
+				Text: message,
+			},
+		},
+		IsError: true,
+	}
+}
+
+// jsonToolResult formats a response as JSON and wraps it as an MCP result
+func jsonToolResult(logger *logrus.Logger, response interface{}) *mcp.CallToolResult {
+	jsonResponse, err := formatJSONResponse(response)
+	if err != nil {
+		logger.WithError(err).Error("Failed to format response")
+		return toolErrorResult(fmt.Sprintf("Error formatting response: %s", err.Error()))
+	}
+	
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Type: "text",
+				Text: jsonResponse,
+			},
+		},
+	}
+}
+
+// rosterPointsFor returns a roster's points for, including the decimal part Sleeper reports separately
+func rosterPointsFor(settings sleeper.RosterSettings) float64 {
+	return settings.FPTS + settings.FPTSDecimal/100
+}
+
+// rosterPointsAgainst returns a roster's points against, including the decimal part
+func rosterPointsAgainst(settings sleeper.RosterSettings) float64 {
+	return settings.FPTSAgainst + settings.FPTSAgainstDecimal/100
+}
+
+// regularSeasonWeeks returns the first and last regular season weeks for a league
+func regularSeasonWeeks(league *sleeper.League) (int, int) {
+	startWeek, endWeek := 1, 14 // Standard regular season
+	if league == nil {
+		return startWeek, endWeek
+	}
+	
+	if league.Settings.StartWeek > 0 {
+		startWeek = league.Settings.StartWeek
+	}
+	if league.Settings.PlayoffWeekStart > 1 {
+		endWeek = league.Settings.PlayoffWeekStart - 1
+	}
+	
+	return startWeek, endWeek
+}
+
+// matchupPoints returns a team's score for a matchup, honouring commissioner overrides
+func matchupPoints(matchup sleeper.Matchup) float64 {
+	if matchup.CustomPoints != nil {
+		return *matchup.CustomPoints
+	}
+	
+	return matchup.Points
+}
+
+// groupMatchupsByID pairs weekly matchup rows by matchup_id, skipping teams without an opponent
+func groupMatchupsByID(matchups []sleeper.Matchup) map[int][]sleeper.Matchup {
+	groups := make(map[int][]sleeper.Matchup)
+	for _, matchup := range matchups {
+		if matchup.MatchupID == 0 {
+			continue
+		}
+		groups[matchup.MatchupID] = append(groups[matchup.MatchupID], matchup)
+	}
+	
+	return groups
+}
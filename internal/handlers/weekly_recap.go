@@ -0,0 +1,459 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+)
+
+// RecapGame represents a single game's result in a weekly recap
+type RecapGame struct {
+	MatchupID int         `json:"matchup_id"`
+	Winner    WeeklyScore `json:"winner"` // Higher score; equal to loser's score on a tie
+	Loser     WeeklyScore `json:"loser"`
+	Margin    float64     `json:"margin"`
+	Tie       bool        `json:"tie,omitempty"`
+}
+
+// BenchAward represents the team whose bench scored the most
+type BenchAward struct {
+	Team           WeeklyScore    `json:"team"`
+	BenchPoints    float64        `json:"bench_points"`
+	TopBenchPlayer *MatchupPlayer `json:"top_bench_player,omitempty"`
+}
+
+// LineupBlunder represents the most points left on a team's bench
+type LineupBlunder struct {
+	Team              WeeklyScore     `json:"team"`
+	ActualPoints      float64         `json:"actual_points"`
+	OptimalPoints     float64         `json:"optimal_points"`
+	PointsLeft        float64         `json:"points_left"`
+	OpponentPoints    float64         `json:"opponent_points"`
+	CostWin           bool            `json:"cost_win"` // The optimal lineup would have won a lost game
+	ShouldHaveStarted []MatchupPlayer `json:"should_have_started"`
+	ShouldHaveSat     []MatchupPlayer `json:"should_have_sat"`
+}
+
+// WaiverPickupAward represents the best-scoring player added this week
+type WaiverPickupAward struct {
+	PlayerID  string      `json:"player_id"`
+	Name      string      `json:"name"`
+	Position  string      `json:"position,omitempty"`
+	Team      WeeklyScore `json:"team"`
+	Type      string      `json:"type"` // "waiver" or "free_agent"
+	WaiverBid int         `json:"waiver_bid,omitempty"`
+	Points    float64     `json:"points"`
+	Started   bool        `json:"started"`
+}
+
+// StandingsMove represents a team's change in standings position over the week
+type StandingsMove struct {
+	RosterID     int    `json:"roster_id"`
+	DisplayName  string `json:"display_name,omitempty"`
+	TeamName     string `json:"team_name,omitempty"`
+	Record       string `json:"record"`
+	Rank         int    `json:"rank"`
+	PreviousRank int    `json:"previous_rank,omitempty"`
+	Change       int    `json:"change"` // Positive when moving up
+}
+
+// WeeklyRecap represents a week's awards and results for narration
+type WeeklyRecap struct {
+	LeagueName        string             `json:"league_name,omitempty"`
+	Season            string             `json:"season,omitempty"`
+	Week              int                `json:"week"`
+	HighScore         *WeeklyScore       `json:"high_score,omitempty"`
+	LowScore          *WeeklyScore       `json:"low_score,omitempty"`
+	BiggestBlowout    *RecapGame         `json:"biggest_blowout,omitempty"`
+	ClosestGame       *RecapGame         `json:"closest_game,omitempty"`
+	BestBench         *BenchAward        `json:"best_bench,omitempty"`
+	BiggestBlunder    *LineupBlunder     `json:"biggest_blunder,omitempty"`
+	TopWaiverPickup   *WaiverPickupAward `json:"top_waiver_pickup,omitempty"`
+	StandingsMovement []StandingsMove    `json:"standings_movement,omitempty"`
+	Results           []RecapGame        `json:"results"`
+	Notes             []string           `json:"notes,omitempty"`
+}
+
+// GenerateWeeklyRecapTool returns the MCP tool definition for generate_weekly_recap
+func (h *LeagueHandler) GenerateWeeklyRecapTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        "generate_weekly_recap",
+		Description: "Generate a week's recap awards: high and low score, biggest blowout, closest game, best bench, biggest lineup blunder, top waiver pickup and standings movement since the prior week",
+		InputSchema: mcp.ToolInputSchema{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"league_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The Sleeper league ID",
+					"required":    true,
+				},
+				"week": map[string]interface{}{
+					"type":        "integer",
+					"description": "Week number (default: last scored week)",
+					"required":    false,
+				},
+			},
+		},
+	}
+}
+
+// HandleGenerateWeeklyRecap handles the generate_weekly_recap tool call
+func (h *LeagueHandler) HandleGenerateWeeklyRecap(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	h.logger.WithField("args", args).Info("Handling generate_weekly_recap")
+
+	// Parse arguments
+	leagueID, ok := args["league_id"].(string)
+	if !ok || leagueID == "" {
+		return nil, fmt.Errorf("league_id is required and must be a string")
+	}
+
+	matchupCtx := h.loadMatchupContext(leagueID, true)
+	apiCalls := matchupCtx.apiCalls
+
+	week := 0
+	if weekFloat, ok := args["week"].(float64); ok {
+		week = int(weekFloat)
+	} else if matchupCtx.league != nil {
+		week = matchupCtx.league.Settings.LastScoredLeg
+	}
+	if week < 1 || week > 18 {
+		return nil, fmt.Errorf("week must be between 1 and 18")
+	}
+
+	matchups, err := h.client.GetMatchups(leagueID, week)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get matchups")
+		return toolErrorResult(fmt.Sprintf("Failed to get matchups: %s", err.Error())), nil
+	}
+	apiCalls++
+
+	transactions, txErr := h.client.GetTransactions(leagueID, week)
+	apiCalls++
+	if txErr != nil {
+		h.logger.WithError(txErr).Warn("Failed to get transactions, skipping waiver award")
+	}
+
+	recap := buildWeeklyRecap(week, matchups, transactions, matchupCtx)
+	if txErr != nil {
+		recap.Notes = append(recap.Notes, "Transactions unavailable, top waiver pickup skipped")
+	}
+
+	// Standings movement compares records through this week and the week before
+	if matchupCtx.league != nil {
+		startWeek, endWeek := regularSeasonWeeks(matchupCtx.league)
+		if week > endWeek {
+			recap.Notes = append(recap.Notes, "Playoff week, standings movement not tracked")
+		} else {
+			records, _, _, warnings, calls := h.recomputeRecordsFromMatchups(leagueID, matchupCtx.league, week)
+			apiCalls += calls
+			h.logAuditWarnings(leagueID, warnings)
+			tiebreaks := standingsTiebreaks{
+				order: h.leagueTiebreakOrder(leagueID, matchupCtx.league),
+				seed:  h.randomTiebreakerSeed(leagueID, matchupCtx.league),
+			}
+			recap.StandingsMovement = standingsMovement(records, week, startWeek, tiebreaks, matchupCtx)
+		}
+	}
+
+	summary := fmt.Sprintf("Week %d recap: %d games", week, len(recap.Results))
+	if recap.HighScore != nil {
+		summary += fmt.Sprintf(", high score %s (%.2f)", recap.HighScore.DisplayName, recap.HighScore.Points)
+	}
+
+	response := sleeper.APIResponse{
+		Success: true,
+		Data:    recap,
+		Summary: summary,
+		Metadata: sleeper.Metadata{
+			Timestamp:    time.Now(),
+			Source:       "sleeper_api",
+			APICallsUsed: apiCalls,
+			LeagueID:     leagueID,
+		},
+	}
+
+	return jsonToolResult(h.logger, response), nil
+}
+
+// buildWeeklyRecap computes a week's game awards from its matchups and transactions
+func buildWeeklyRecap(week int, matchups []sleeper.Matchup, transactions []sleeper.Transaction, mc *matchupContext) *WeeklyRecap {
+	weekly := buildWeeklyMatchups(week, matchups, mc)
+	recap := &WeeklyRecap{
+		Week:      week,
+		HighScore: weekly.HighScore,
+		LowScore:  weekly.LowScore,
+	}
+	if mc.league != nil {
+		recap.LeagueName = mc.league.Name
+		recap.Season = mc.league.Season
+	}
+
+	for _, pairing := range weekly.Matchups {
+		game := RecapGame{
+			MatchupID: pairing.MatchupID,
+			Winner:    teamScore(pairing.Teams[0]),
+			Loser:     teamScore(pairing.Teams[1]),
+			Margin:    pairing.Margin,
+			Tie:       pairing.WinnerRosterID == 0,
+		}
+		recap.Results = append(recap.Results, game)
+
+		if recap.BiggestBlowout == nil || game.Margin > recap.BiggestBlowout.Margin {
+			blowout := game
+			recap.BiggestBlowout = &blowout
+		}
+		if recap.ClosestGame == nil || game.Margin < recap.ClosestGame.Margin {
+			closest := game
+			recap.ClosestGame = &closest
+		}
+
+		for _, team := range pairing.Teams {
+			if recap.BestBench == nil || team.BenchPoints > recap.BestBench.BenchPoints {
+				award := &BenchAward{Team: teamScore(team), BenchPoints: team.BenchPoints}
+				if len(team.Bench) > 0 {
+					top := team.Bench[0]
+					award.TopBenchPlayer = &top
+				}
+				recap.BestBench = award
+			}
+		}
+	}
+
+	if mc.league != nil && len(mc.players) > 0 {
+		recap.BiggestBlunder = biggestLineupBlunder(matchups, weekly, mc)
+	} else {
+		recap.Notes = append(recap.Notes, "League or player data unavailable, lineup blunder skipped")
+	}
+
+	recap.TopWaiverPickup = topWaiverPickup(matchups, transactions, mc)
+
+	return recap
+}
+
+// biggestLineupBlunder finds the team that left the most points on its bench
+func biggestLineupBlunder(matchups []sleeper.Matchup, weekly *WeeklyMatchups, mc *matchupContext) *LineupBlunder {
+	slots := startingRosterSlots(mc.league.RosterPositions)
+	byRoster := make(map[int]sleeper.Matchup)
+	for _, matchup := range matchups {
+		byRoster[matchup.RosterID] = matchup
+	}
+
+	var blunder *LineupBlunder
+	for _, pairing := range weekly.Matchups {
+		for i, team := range pairing.Teams {
+			opponent := pairing.Teams[1-i]
+			optimalPoints, optimalIDs := optimalLineup(slots, mc.lineupCandidates(byRoster[team.RosterID]))
+
+			actualPoints := 0.0
+			for _, starter := range team.Starters {
+				actualPoints += starter.Points
+			}
+			actualPoints = math.Round(actualPoints*100) / 100
+
+			pointsLeft := math.Round((optimalPoints-actualPoints)*100) / 100
+			if pointsLeft <= 0 || (blunder != nil && pointsLeft <= blunder.PointsLeft) {
+				continue
+			}
+
+			candidate := &LineupBlunder{
+				Team:           teamScore(team),
+				ActualPoints:   actualPoints,
+				OptimalPoints:  optimalPoints,
+				PointsLeft:     pointsLeft,
+				OpponentPoints: opponent.Points,
+				CostWin:        team.Result != "W" && team.Points+pointsLeft > opponent.Points,
+			}
+
+			optimalSet := make(map[string]bool)
+			for _, playerID := range optimalIDs {
+				optimalSet[playerID] = true
+			}
+			starterSet := make(map[string]bool)
+			for _, starter := range team.Starters {
+				starterSet[starter.PlayerID] = true
+				if !optimalSet[starter.PlayerID] && starter.PlayerID != "" && starter.PlayerID != "0" {
+					candidate.ShouldHaveSat = append(candidate.ShouldHaveSat, starter)
+				}
+			}
+			for _, benched := range team.Bench {
+				if optimalSet[benched.PlayerID] && !starterSet[benched.PlayerID] {
+					candidate.ShouldHaveStarted = append(candidate.ShouldHaveStarted, benched)
+				}
+			}
+
+			blunder = candidate
+		}
+	}
+
+	return blunder
+}
+
+// topWaiverPickup finds the highest-scoring player added by waiver or free agency this week
+func topWaiverPickup(matchups []sleeper.Matchup, transactions []sleeper.Transaction, mc *matchupContext) *WaiverPickupAward {
+	byRoster := make(map[int]sleeper.Matchup)
+	for _, matchup := range matchups {
+		byRoster[matchup.RosterID] = matchup
+	}
+
+	var best *WaiverPickupAward
+	for _, transaction := range transactions {
+		if transaction.Status != "complete" || (transaction.Type != "waiver" && transaction.Type != "free_agent") {
+			continue
+		}
+
+		for playerID, rosterID := range transaction.Adds {
+			matchup, exists := byRoster[rosterID]
+			if !exists {
+				continue
+			}
+
+			points := matchup.PlayersPoints[playerID]
+			if best != nil && (points < best.Points || (points == best.Points && playerID > best.PlayerID)) {
+				continue
+			}
+
+			started := false
+			for _, starterID := range matchup.Starters {
+				if starterID == playerID {
+					started = true
+					break
+				}
+			}
+
+			line := mc.matchupPlayer(playerID, points)
+			_, displayName, teamName := mc.owner(rosterID)
+			award := &WaiverPickupAward{
+				PlayerID: playerID,
+				Name:     line.Name,
+				Position: line.Position,
+				Team: WeeklyScore{
+					RosterID:    rosterID,
+					DisplayName: displayName,
+					TeamName:    teamName,
+					Points:      matchupPoints(matchup),
+				},
+				Type:    transaction.Type,
+				Points:  points,
+				Started: started,
+			}
+			if bid, ok := transaction.Settings["waiver_bid"].(float64); ok {
+				award.WaiverBid = int(bid)
+			}
+			best = award
+		}
+	}
+
+	return best
+}
+
+// standingsTiebreaks holds the tiebreakers used to rank standings movement
+type standingsTiebreaks struct {
+	order []string
+	seed  string // Seed for the random tiebreaker
+}
+
+// standingsMovement ranks teams after this week and the prior week from recomputed
+// records, using the league's tiebreakers
+func standingsMovement(records map[int]*RecomputedRecord, week, startWeek int, tiebreaks standingsTiebreaks, mc *matchupContext) []StandingsMove {
+	ranks, recordStrings := standingsRanksThroughWeek(records, week, tiebreaks)
+	var previousRanks map[int]int
+	if week > startWeek {
+		previousRanks, _ = standingsRanksThroughWeek(records, week-1, tiebreaks)
+	}
+
+	var moves []StandingsMove
+	for rosterID, rank := range ranks {
+		_, displayName, teamName := mc.owner(rosterID)
+		move := StandingsMove{
+			RosterID:    rosterID,
+			DisplayName: displayName,
+			TeamName:    teamName,
+			Record:      recordStrings[rosterID],
+			Rank:        rank,
+		}
+		if previous, exists := previousRanks[rosterID]; exists {
+			move.PreviousRank = previous
+			move.Change = previous - rank
+		}
+		moves = append(moves, move)
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].Rank < moves[j].Rank
+	})
+
+	return moves
+}
+
+// standingsRanksThroughWeek ranks teams using only the weeks up to and including week.
+// Records and the head-to-head matrix are rebuilt from those weeks and sorted with the
+// league's tiebreakers.
+func standingsRanksThroughWeek(records map[int]*RecomputedRecord, week int, tiebreaks standingsTiebreaks) (map[int]int, map[int]string) {
+	var standings []StandingEntry
+	headToHeadMatrix := make(map[int]map[int]int)
+	for rosterID, record := range records {
+		entry := StandingEntry{RosterID: rosterID}
+		played := false
+		for _, result := range record.Weeks {
+			if result.Week > week {
+				continue
+			}
+			played = true
+			entry.PointsFor += result.PointsFor
+			entry.PointsAgainst += result.PointsAgainst
+			for _, outcome := range []string{result.Result, result.MedianResult} {
+				switch outcome {
+				case "W":
+					entry.Wins++
+				case "L":
+					entry.Losses++
+				case "T":
+					entry.Ties++
+				}
+			}
+			if result.Result == "W" && result.OpponentRosterID != 0 {
+				if headToHeadMatrix[rosterID] == nil {
+					headToHeadMatrix[rosterID] = make(map[int]int)
+				}
+				headToHeadMatrix[rosterID][result.OpponentRosterID]++
+			}
+		}
+		if !played {
+			continue
+		}
+		if containsTiebreaker(tiebreaks.order, "random") {
+			entry.RandomTiebreakerID = generateRandomTiebreakerID(tiebreaks.seed, rosterID)
+		}
+		standings = append(standings, entry)
+	}
+
+	// Sort by roster first so fully tied teams keep a stable order
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].RosterID < standings[j].RosterID
+	})
+	standings = sortStandingsWithTiebreakers(standings, tiebreaks.order, nil, headToHeadMatrix)
+
+	ranks := make(map[int]int)
+	recordStrings := make(map[int]string)
+	for i, entry := range standings {
+		ranks[entry.RosterID] = i + 1
+		recordStrings[entry.RosterID] = fmt.Sprintf("%d-%d-%d", entry.Wins, entry.Losses, entry.Ties)
+	}
+
+	return ranks, recordStrings
+}
+
+// teamScore summarizes a matchup team for award output
+func teamScore(team MatchupTeam) WeeklyScore {
+	return WeeklyScore{
+		RosterID:    team.RosterID,
+		DisplayName: team.DisplayName,
+		TeamName:    team.TeamName,
+		Points:      team.Points,
+	}
+}
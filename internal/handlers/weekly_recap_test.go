@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/sam-maryland/sleeper-mcp-server/internal/sleeper"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestOptimalLineup(t *testing.T) {
+	slots := []string{"QB", "RB", "WR", "FLEX", "SUPER_FLEX"}
+	candidates := []lineupCandidate{
+		{PlayerID: "qb1", Positions: []string{"QB"}, Points: 25},
+		{PlayerID: "qb2", Positions: []string{"QB"}, Points: 18},
+		{PlayerID: "rb1", Positions: []string{"RB"}, Points: 12},
+		{PlayerID: "wr1", Positions: []string{"WR"}, Points: 20},
+		{PlayerID: "wr2", Positions: []string{"WR"}, Points: 15},
+		{PlayerID: "te1", Positions: []string{"TE"}, Points: 9},
+	}
+
+	points, chosen := optimalLineup(slots, candidates)
+
+	// QB 25 + RB 12 + WR 20 + FLEX 15 + SUPER_FLEX 18
+	if points != 90 {
+		t.Errorf("Expected optimal lineup of 90 points, got %.2f", points)
+	}
+	expected := []string{"qb1", "rb1", "wr1", "wr2", "qb2"}
+	for i, playerID := range expected {
+		if chosen[i] != playerID {
+			t.Errorf("Expected slot %s to be %s, got %s", slots[i], playerID, chosen[i])
+		}
+	}
+}
+
+func TestOptimalLineup_IDP(t *testing.T) {
+	slots := []string{"DL", "LB", "DB", "IDP_FLEX"}
+	players := map[string]sleeper.Player{
+		"de1": {PlayerID: "de1", Position: "DE"},
+		"lb1": {PlayerID: "lb1", Position: "LB", FantasyPositions: []string{"LB"}},
+		"lb2": {PlayerID: "lb2", Position: "OLB"},
+		"cb1": {PlayerID: "cb1", Position: "CB"},
+		"hy1": {PlayerID: "hy1", Position: "LB", FantasyPositions: []string{"DL", "LB"}},
+	}
+	points := map[string]float64{"de1": 6, "lb1": 12, "lb2": 9, "cb1": 7, "hy1": 10}
+
+	var candidates []lineupCandidate
+	for _, playerID := range []string{"de1", "lb1", "lb2", "cb1", "hy1"} {
+		candidates = append(candidates, lineupCandidate{PlayerID: playerID, Positions: playerPositions(players[playerID]), Points: points[playerID]})
+	}
+
+	total, chosen := optimalLineup(slots, candidates)
+
+	// The DL/LB hybrid plays DL, freeing both linebackers for LB and IDP_FLEX
+	if total != 38 {
+		t.Errorf("Expected optimal IDP lineup of 38 points, got %.2f", total)
+	}
+	expected := []string{"hy1", "lb1", "cb1", "lb2"}
+	for i, playerID := range expected {
+		if chosen[i] != playerID {
+			t.Errorf("Expected slot %s to be %s, got %s", slots[i], playerID, chosen[i])
+		}
+	}
+}
+
+func TestOptimalLineup_HybridOutscoresSpecialists(t *testing.T) {
+	slots := []string{"LB", "DL"}
+	candidates := []lineupCandidate{
+		{PlayerID: "hy1", Positions: []string{"DL", "LB"}, Points: 20},
+		{PlayerID: "lb1", Positions: []string{"LB"}, Points: 15},
+		{PlayerID: "dl1", Positions: []string{"DL"}, Points: 1},
+	}
+
+	total, chosen := optimalLineup(slots, candidates)
+
+	// Taking the hybrid for LB first would leave the 1-point DL and total 21
+	if total != 35 || chosen[0] != "lb1" || chosen[1] != "hy1" {
+		t.Errorf("Expected lb1 at LB and hy1 at DL for 35 points, got %v for %.2f", chosen, total)
+	}
+
+	// Slots nobody can fill stay empty without costing the others
+	total, chosen = optimalLineup([]string{"K", "LB", "DL"}, candidates)
+	if total != 35 || chosen[0] != "" {
+		t.Errorf("Expected an empty K slot and 35 points, got %v for %.2f", chosen, total)
+	}
+}
+
+func TestLeagueHandler_GenerateWeeklyRecap(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	weeklyMatchups := map[int][]sleeper.Matchup{
+		1: {
+			{RosterID: 1, MatchupID: 1, Points: 100},
+			{RosterID: 2, MatchupID: 1, Points: 110},
+			{RosterID: 3, MatchupID: 2, Points: 90},
+			{RosterID: 4, MatchupID: 2, Points: 80},
+		},
+		2: {
+			{
+				RosterID:       1,
+				MatchupID:      1,
+				Points:         95,
+				Starters:       []string{"wr1"},
+				StartersPoints: []float64{95},
+				Players:        []string{"wr1", "wr9"},
+				PlayersPoints:  map[string]float64{"wr1": 95, "wr9": 4},
+			},
+			{
+				RosterID:       2,
+				MatchupID:      1,
+				Points:         60,
+				Starters:       []string{"wr2"},
+				StartersPoints: []float64{60},
+				Players:        []string{"wr2", "wr3"},
+				PlayersPoints:  map[string]float64{"wr2": 60, "wr3": 70},
+			},
+			{RosterID: 3, MatchupID: 2, Points: 88.5},
+			{RosterID: 4, MatchupID: 2, Points: 88},
+		},
+	}
+
+	mockClient := &MockSleeperClient{
+		GetLeagueFunc: func(leagueID string) (*sleeper.League, error) {
+			return &sleeper.League{
+				LeagueID:        leagueID,
+				Season:          "2024",
+				RosterPositions: []string{"WR", "BN"},
+				Settings:        sleeper.LeagueSettings{LastScoredLeg: 2},
+			}, nil
+		},
+		GetLeagueRostersFunc: func(leagueID string) ([]sleeper.Roster, error) {
+			return []sleeper.Roster{
+				{RosterID: 1, OwnerID: "user1"},
+				{RosterID: 2, OwnerID: "user2"},
+				{RosterID: 3, OwnerID: "user3"},
+				{RosterID: 4, OwnerID: "user4"},
+			}, nil
+		},
+		GetLeagueUsersFunc: func(leagueID string) ([]sleeper.User, error) {
+			return []sleeper.User{
+				{UserID: "user1", DisplayName: "Player One"},
+				{UserID: "user2", DisplayName: "Player Two"},
+			}, nil
+		},
+		GetAllPlayersFunc: func() (map[string]sleeper.Player, error) {
+			return map[string]sleeper.Player{
+				"wr1": {PlayerID: "wr1", FullName: "Wide One", Position: "WR"},
+				"wr2": {PlayerID: "wr2", FullName: "Wide Two", Position: "WR"},
+				"wr3": {PlayerID: "wr3", FullName: "Wide Three", Position: "WR"},
+				"wr9": {PlayerID: "wr9", FullName: "Wide Nine", Position: "WR"},
+			}, nil
+		},
+		GetMatchupsFunc: func(leagueID string, week int) ([]sleeper.Matchup, error) {
+			return weeklyMatchups[week], nil
+		},
+		GetTransactionsFunc: func(leagueID string, week int) ([]sleeper.Transaction, error) {
+			return []sleeper.Transaction{
+				{Type: "waiver", Status: "complete", Adds: map[string]int{"wr3": 2}, Settings: map[string]interface{}{"waiver_bid": float64(12)}},
+				{Type: "free_agent", Status: "complete", Adds: map[string]int{"wr9": 1}},
+				{Type: "waiver", Status: "failed", Adds: map[string]int{"wr1": 3}},
+			}, nil
+		},
+	}
+
+	handler := NewLeagueHandler(mockClient, logger)
+	result, err := handler.HandleGenerateWeeklyRecap(context.Background(), map[string]interface{}{
+		"league_id": "123456789",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result")
+	}
+
+	var response struct {
+		Data WeeklyRecap `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	recap := response.Data
+
+	if recap.Week != 2 {
+		t.Errorf("Expected recap to default to last scored week 2, got %d", recap.Week)
+	}
+	if recap.HighScore == nil || recap.HighScore.RosterID != 1 || recap.LowScore.RosterID != 2 {
+		t.Errorf("Expected high score roster 1 and low score roster 2, got %+v / %+v", recap.HighScore, recap.LowScore)
+	}
+	if recap.BiggestBlowout == nil || recap.BiggestBlowout.MatchupID != 1 || recap.BiggestBlowout.Margin != 35 {
+		t.Errorf("Expected matchup 1 blowout by 35, got %+v", recap.BiggestBlowout)
+	}
+	if recap.ClosestGame == nil || recap.ClosestGame.MatchupID != 2 || recap.ClosestGame.Margin != 0.5 {
+		t.Errorf("Expected matchup 2 closest by 0.5, got %+v", recap.ClosestGame)
+	}
+	if recap.BestBench == nil || recap.BestBench.Team.RosterID != 2 || recap.BestBench.BenchPoints != 70 {
+		t.Errorf("Expected roster 2 best bench with 70, got %+v", recap.BestBench)
+	}
+
+	blunder := recap.BiggestBlunder
+	if blunder == nil || blunder.Team.RosterID != 2 || blunder.PointsLeft != 10 {
+		t.Fatalf("Expected roster 2 to leave 10 points on the bench, got %+v", blunder)
+	}
+	if blunder.CostWin {
+		t.Error("Expected blunder not to have cost the win (70 < 95)")
+	}
+	if len(blunder.ShouldHaveStarted) != 1 || blunder.ShouldHaveStarted[0].PlayerID != "wr3" {
+		t.Errorf("Expected wr3 should have started, got %+v", blunder.ShouldHaveStarted)
+	}
+
+	pickup := recap.TopWaiverPickup
+	if pickup == nil || pickup.PlayerID != "wr3" || pickup.Points != 70 || pickup.Started || pickup.WaiverBid != 12 {
+		t.Errorf("Expected wr3 as top waiver pickup with 70 bench points and $12 bid, got %+v", pickup)
+	}
+
+	// Week 1 order: 2, 3, 1, 4. Week 2 order: 3 (2-0), 1 (1-1, 195), 2 (1-1, 170), 4
+	moves := make(map[int]StandingsMove)
+	for _, move := range recap.StandingsMovement {
+		moves[move.RosterID] = move
+	}
+	if moves[3].Rank != 1 || moves[3].Record != "2-0-0" {
+		t.Errorf("Expected roster 3 to lead at 2-0-0, got %+v", moves[3])
+	}
+	if moves[2].PreviousRank != 1 || moves[2].Rank != 3 || moves[2].Change != -2 {
+		t.Errorf("Expected roster 2 to drop from 1st to 3rd, got %+v", moves[2])
+	}
+	if moves[1].PreviousRank != 3 || moves[1].Rank != 2 || moves[1].Change != 1 {
+		t.Errorf("Expected roster 1 to rise from 3rd to 2nd, got %+v", moves[1])
+	}
+}
+
+func TestStandingsRanksThroughWeek_Tiebreakers(t *testing.T) {
+	// Rosters 1 and 2 are both 1-1 after week 2; roster 1 won the head-to-head game
+	// but roster 2 has scored more
+	records := map[int]*RecomputedRecord{
+		1: {RosterID: 1, Weeks: []WeeklyTeamResult{
+			{Week: 1, OpponentRosterID: 2, PointsFor: 100, PointsAgainst: 90, Result: "W"},
+			{Week: 2, OpponentRosterID: 3, PointsFor: 80, PointsAgainst: 120, Result: "L"},
+		}},
+		2: {RosterID: 2, Weeks: []WeeklyTeamResult{
+			{Week: 1, OpponentRosterID: 1, PointsFor: 90, PointsAgainst: 100, Result: "L"},
+			{Week: 2, OpponentRosterID: 4, PointsFor: 150, PointsAgainst: 70, Result: "W"},
+		}},
+		3: {RosterID: 3, Weeks: []WeeklyTeamResult{
+			{Week: 1, OpponentRosterID: 4, PointsFor: 110, PointsAgainst: 60, Result: "W"},
+			{Week: 2, OpponentRosterID: 1, PointsFor: 120, PointsAgainst: 80, Result: "W"},
+		}},
+		4: {RosterID: 4, Weeks: []WeeklyTeamResult{
+			{Week: 1, OpponentRosterID: 3, PointsFor: 60, PointsAgainst: 110, Result: "L"},
+			{Week: 2, OpponentRosterID: 2, PointsFor: 70, PointsAgainst: 150, Result: "L"},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		order    []string
+		expected []int // Roster IDs by rank
+	}{
+		{"points for", []string{"wins", "points_for"}, []int{3, 2, 1, 4}},
+		{"head to head", []string{"wins", "head_to_head", "points_for"}, []int{3, 1, 2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranks, recordStrings := standingsRanksThroughWeek(records, 2, standingsTiebreaks{order: tt.order})
+			for i, rosterID := range tt.expected {
+				if ranks[rosterID] != i+1 {
+					t.Errorf("Expected roster %d at rank %d, got %d", rosterID, i+1, ranks[rosterID])
+				}
+			}
+			if recordStrings[1] != "1-1-0" {
+				t.Errorf("Expected roster 1 at 1-1-0, got %s", recordStrings[1])
+			}
+		})
+	}
+}
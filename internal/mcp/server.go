@@ -16,15 +16,17 @@ type SleeperMCPServer struct {
 	sleeperClient sleeper.Client
 	leagueHandler *handlers.LeagueHandler
 	rosterHandler *handlers.RosterHandler
+	playerHandler *handlers.PlayerHandler
 }
 
 func NewSleeperMCPServer(logger *logrus.Logger) *server.DefaultServer {
-	// Create Sleeper API client
-	sleeperClient := sleeper.NewHTTPClient(logger)
+	// Create Sleeper API client, caching players and live scoring data
+	sleeperClient := sleeper.NewCachedClient(sleeper.NewHTTPClient(logger), sleeper.DefaultCacheConfig())
 	
 	// Create handlers
 	leagueHandler := handlers.NewLeagueHandler(sleeperClient, logger)
 	rosterHandler := handlers.NewRosterHandler(sleeperClient, logger)
+	playerHandler := handlers.NewPlayerHandler(sleeperClient, logger)
 	
 	// Create MCP server
 	s := server.NewDefaultServer("Sleeper Fantasy Football", "1.0.0")
@@ -45,10 +47,26 @@ func NewSleeperMCPServer(logger *logrus.Logger) *server.DefaultServer {
 			leagueHandler.GetMatchupsTool(),
 			leagueHandler.DiscoverLeagueHistoryTool(),
 			leagueHandler.GetLeagueHistoryTool(),
+			leagueHandler.AuditStandingsTool(),
+			leagueHandler.GetLiveMatchupsTool(),
+			leagueHandler.GenerateWeeklyRecapTool(),
+			leagueHandler.GetLeagueRecordsTool(),
+			leagueHandler.GetRivalryTool(),
+			leagueHandler.GetOwnerCareersTool(),
+			leagueHandler.RescoreSeasonTool(),
+			leagueHandler.GetScheduleLuckTool(),
+			leagueHandler.GetSettingsHistoryTool(),
 			rosterHandler.GetRosterTool(),
 			rosterHandler.GetAllRostersTool(),
 			rosterHandler.AnalyzeRosterStrengthTool(),
 			rosterHandler.CompareRostersTool(),
+			rosterHandler.GetPositionalRankingsTool(),
+			rosterHandler.GetRemainingScheduleTool(),
+			rosterHandler.CheckLineupsTool(),
+			rosterHandler.ValidateRosterRulesTool(),
+			playerHandler.SearchPlayersTool(),
+			playerHandler.GetTrendingPlayersTool(),
+			playerHandler.GetFreeAgentsTool(),
 		}
 		
 		logger.WithField("tools_count", len(tools)).Info("Listing available tools")
@@ -79,6 +97,24 @@ func NewSleeperMCPServer(logger *logrus.Logger) *server.DefaultServer {
 			return leagueHandler.HandleDiscoverLeagueHistory(ctx, arguments)
 		case "get_league_history":
 			return leagueHandler.HandleGetLeagueHistory(ctx, arguments)
+		case "audit_standings":
+			return leagueHandler.HandleAuditStandings(ctx, arguments)
+		case "get_live_matchups":
+			return leagueHandler.HandleGetLiveMatchups(ctx, arguments)
+		case "generate_weekly_recap":
+			return leagueHandler.HandleGenerateWeeklyRecap(ctx, arguments)
+		case "get_league_records":
+			return leagueHandler.HandleGetLeagueRecords(ctx, arguments)
+		case "get_rivalry":
+			return leagueHandler.HandleGetRivalry(ctx, arguments)
+		case "get_owner_careers":
+			return leagueHandler.HandleGetOwnerCareers(ctx, arguments)
+		case "rescore_season":
+			return leagueHandler.HandleRescoreSeason(ctx, arguments)
+		case "get_schedule_luck":
+			return leagueHandler.HandleGetScheduleLuck(ctx, arguments)
+		case "get_settings_history":
+			return leagueHandler.HandleGetSettingsHistory(ctx, arguments)
 		case "get_roster":
 			return rosterHandler.HandleGetRoster(ctx, arguments)
 		case "get_all_rosters":
@@ -87,6 +123,20 @@ func NewSleeperMCPServer(logger *logrus.Logger) *server.DefaultServer {
 			return rosterHandler.HandleAnalyzeRosterStrength(ctx, arguments)
 		case "compare_rosters":
 			return rosterHandler.HandleCompareRosters(ctx, arguments)
+		case "get_positional_rankings":
+			return rosterHandler.HandleGetPositionalRankings(ctx, arguments)
+		case "get_remaining_schedule":
+			return rosterHandler.HandleGetRemainingSchedule(ctx, arguments)
+		case "check_lineups":
+			return rosterHandler.HandleCheckLineups(ctx, arguments)
+		case "validate_roster_rules":
+			return rosterHandler.HandleValidateRosterRules(ctx, arguments)
+		case "search_players":
+			return playerHandler.HandleSearchPlayers(ctx, arguments)
+		case "get_trending_players":
+			return playerHandler.HandleGetTrendingPlayers(ctx, arguments)
+		case "get_free_agents":
+			return playerHandler.HandleGetFreeAgents(ctx, arguments)
 		default:
 			logger.WithField("tool", name).Warn("Unknown tool called")
 			return &mcp.CallToolResult{
@@ -0,0 +1,240 @@
+// Package nfl provides NFL schedule data: each season's games and bye weeks.
+package nfl
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// bundledSchedules holds the schedules shipped with the server, one file per season
+//
+//go:embed schedules/*.json
+var bundledSchedules embed.FS
+
+// Game represents one NFL game
+type Game struct {
+	Week int    `json:"week"`
+	Home string `json:"home"`
+	Away string `json:"away"`
+}
+
+// SeasonSchedule represents one NFL season's regular season. Files may list games, byes
+// or both; byes are derived from a complete list of games when only games are given.
+type SeasonSchedule struct {
+	Season string              `json:"season"`
+	Games  []Game              `json:"games,omitempty"`
+	Byes   map[string][]string `json:"byes,omitempty"` // Week number to the teams on bye
+
+	byeWeeks  map[string]int            // Team to bye week
+	opponents map[int]map[string]string // Week to team to opponent
+	homeTeams map[int]map[string]bool   // Week to teams playing at home
+}
+
+// ScheduledGame represents a team's game or bye in one week
+type ScheduledGame struct {
+	Week     int    `json:"week"`
+	Opponent string `json:"opponent,omitempty"`
+	Home     bool   `json:"home,omitempty"`
+	Bye      bool   `json:"bye,omitempty"`
+}
+
+// Store holds NFL schedules by season
+type Store struct {
+	mu      sync.RWMutex
+	seasons map[string]*SeasonSchedule
+}
+
+// NewStore creates a store with the bundled schedules loaded
+func NewStore() (*Store, error) {
+	store := &Store{seasons: make(map[string]*SeasonSchedule)}
+
+	files, err := fs.Glob(bundledSchedules, "schedules/*.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundled schedules: %w", err)
+	}
+	for _, file := range files {
+		data, err := bundledSchedules.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundled schedule %s: %w", file, err)
+		}
+		if err := store.load(data, file); err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
+}
+
+// LoadFile adds a schedule file to the store, replacing any schedule for the same season
+func (s *Store) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read schedule file %s: %w", path, err)
+	}
+	return s.load(data, path)
+}
+
+// load parses and indexes a schedule, then adds it to the store
+func (s *Store) load(data []byte, source string) error {
+	var schedule SeasonSchedule
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return fmt.Errorf("failed to parse schedule %s: %w", source, err)
+	}
+	if schedule.Season == "" {
+		return fmt.Errorf("schedule %s has no season", source)
+	}
+	if err := schedule.index(); err != nil {
+		return fmt.Errorf("invalid schedule %s: %w", source, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seasons[schedule.Season] = &schedule
+	return nil
+}
+
+// Season returns the schedule for a season, or nil when none is loaded
+func (s *Store) Season(season string) *SeasonSchedule {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.seasons[season]
+}
+
+// Seasons returns every loaded season, oldest first
+func (s *Store) Seasons() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	seasons := make([]string, 0, len(s.seasons))
+	for season := range s.seasons {
+		seasons = append(seasons, season)
+	}
+	sort.Strings(seasons)
+	return seasons
+}
+
+// index builds the lookups used by the schedule's accessors
+func (sch *SeasonSchedule) index() error {
+	sch.byeWeeks = make(map[string]int)
+	sch.opponents = make(map[int]map[string]string)
+	sch.homeTeams = make(map[int]map[string]bool)
+
+	teams := make(map[string]bool)
+	for _, game := range sch.Games {
+		if game.Week <= 0 || game.Home == "" || game.Away == "" {
+			return fmt.Errorf("game %+v needs a week, home and away team", game)
+		}
+		if sch.opponents[game.Week] == nil {
+			sch.opponents[game.Week] = make(map[string]string)
+			sch.homeTeams[game.Week] = make(map[string]bool)
+		}
+		sch.opponents[game.Week][game.Home] = game.Away
+		sch.opponents[game.Week][game.Away] = game.Home
+		sch.homeTeams[game.Week][game.Home] = true
+		teams[game.Home] = true
+		teams[game.Away] = true
+	}
+
+	for weekKey, byeTeams := range sch.Byes {
+		week, err := strconv.Atoi(weekKey)
+		if err != nil || week <= 0 {
+			return fmt.Errorf("bye week %q is not a week number", weekKey)
+		}
+		for _, team := range byeTeams {
+			sch.byeWeeks[team] = week
+		}
+	}
+
+	// Without listed byes, a team is on bye in the one listed week it has no game
+	if len(sch.Byes) == 0 && len(sch.Games) > 0 {
+		return sch.deriveByes(teams)
+	}
+
+	return nil
+}
+
+// deriveByes sets each team's bye to the one listed week it has no game. Partial
+// schedules, where a team misses no week or several, can't be derived from.
+func (sch *SeasonSchedule) deriveByes(teams map[string]bool) error {
+	weeks := make([]int, 0, len(sch.opponents))
+	for week := range sch.opponents {
+		weeks = append(weeks, week)
+	}
+	sort.Ints(weeks)
+
+	for team := range teams {
+		var missing []int
+		for _, week := range weeks {
+			if _, plays := sch.opponents[week][team]; !plays {
+				missing = append(missing, week)
+			}
+		}
+		if len(missing) != 1 {
+			return fmt.Errorf("can't derive byes: %s has no game in weeks %v of the listed weeks; list byes explicitly", team, missing)
+		}
+		sch.byeWeeks[team] = missing[0]
+	}
+	return nil
+}
+
+// HasGames reports whether the schedule lists games, not just byes
+func (sch *SeasonSchedule) HasGames() bool {
+	return len(sch.Games) > 0
+}
+
+// ByeWeek returns a team's bye week, or 0 when it isn't known
+func (sch *SeasonSchedule) ByeWeek(team string) int {
+	if sch == nil {
+		return 0
+	}
+	return sch.byeWeeks[team]
+}
+
+// TeamsOnBye returns the teams on bye in a week, sorted
+func (sch *SeasonSchedule) TeamsOnBye(week int) []string {
+	if sch == nil {
+		return nil
+	}
+	var teams []string
+	for team, byeWeek := range sch.byeWeeks {
+		if byeWeek == week {
+			teams = append(teams, team)
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
+
+// Game returns a team's game or bye in a week. The second result is false when the
+// schedule doesn't know what the team is doing that week.
+func (sch *SeasonSchedule) Game(team string, week int) (ScheduledGame, bool) {
+	if sch == nil {
+		return ScheduledGame{}, false
+	}
+	if sch.byeWeeks[team] == week {
+		return ScheduledGame{Week: week, Bye: true}, true
+	}
+	if opponent, plays := sch.opponents[week][team]; plays {
+		return ScheduledGame{Week: week, Opponent: opponent, Home: sch.homeTeams[week][team]}, true
+	}
+	return ScheduledGame{}, false
+}
+
+// Remaining returns a team's games and bye from one week through another
+func (sch *SeasonSchedule) Remaining(team string, fromWeek, toWeek int) []ScheduledGame {
+	var games []ScheduledGame
+	for week := fromWeek; week <= toWeek; week++ {
+		if game, known := sch.Game(team, week); known {
+			games = append(games, game)
+		}
+	}
+	return games
+}
@@ -0,0 +1,119 @@
+package nfl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewStore_Bundled2024(t *testing.T) {
+	store, err := NewStore()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	schedule := store.Season("2024")
+	if schedule == nil {
+		t.Fatal("Expected the 2024 schedule to be bundled")
+	}
+	if len(schedule.byeWeeks) != 32 {
+		t.Errorf("Expected byes for all 32 teams, got %d", len(schedule.byeWeeks))
+	}
+	if week := schedule.ByeWeek("DET"); week != 5 {
+		t.Errorf("Expected DET's bye in week 5, got %d", week)
+	}
+
+	onBye := schedule.TeamsOnBye(9)
+	if len(onBye) != 2 || onBye[0] != "PIT" || onBye[1] != "SF" {
+		t.Errorf("Expected PIT and SF on bye in week 9, got %v", onBye)
+	}
+
+	if store.Season("1999") != nil {
+		t.Error("Expected no schedule for an unloaded season")
+	}
+}
+
+func TestStore_LoadFileDerivesByes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "2030.json")
+	data := `{
+		"season": "2030",
+		"games": [
+			{"week": 1, "home": "PHI", "away": "DAL"},
+			{"week": 1, "home": "NYG", "away": "WAS"},
+			{"week": 2, "home": "DAL", "away": "NYG"},
+			{"week": 3, "home": "WAS", "away": "PHI"}
+		]
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("Failed to write schedule: %v", err)
+	}
+
+	store, err := NewStore()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.LoadFile(path); err != nil {
+		t.Fatalf("Unexpected error loading schedule: %v", err)
+	}
+
+	schedule := store.Season("2030")
+	if !schedule.HasGames() {
+		t.Fatal("Expected the schedule to list games")
+	}
+
+	game, known := schedule.Game("DAL", 1)
+	if !known || game.Opponent != "PHI" || game.Home {
+		t.Errorf("Expected DAL at PHI in week 1, got %+v", game)
+	}
+	game, known = schedule.Game("PHI", 2)
+	if !known || !game.Bye {
+		t.Errorf("Expected PHI on bye in week 2, got %+v", game)
+	}
+	if week := schedule.ByeWeek("DAL"); week != 3 {
+		t.Errorf("Expected DAL's bye in week 3, got %d", week)
+	}
+	if _, known := schedule.Game("PHI", 4); known {
+		t.Error("Expected week 4 to be unknown")
+	}
+
+	remaining := schedule.Remaining("WAS", 1, 3)
+	if len(remaining) != 3 || remaining[0].Opponent != "NYG" || !remaining[1].Bye || !remaining[2].Home {
+		t.Errorf("Expected WAS at NYG, a bye, then home to PHI, got %+v", remaining)
+	}
+
+	if seasons := store.Seasons(); len(seasons) != 2 || seasons[1] != "2030" {
+		t.Errorf("Expected 2024 and 2030 loaded, got %v", seasons)
+	}
+}
+
+func TestStore_LoadFileErrors(t *testing.T) {
+	store, err := NewStore()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dir := t.TempDir()
+	tests := map[string]string{
+		"no_season.json": `{"byes": {"5": ["DET"]}}`,
+		"bad_week.json":  `{"season": "2030", "byes": {"five": ["DET"]}}`,
+		"bad_game.json":  `{"season": "2030", "games": [{"week": 1, "home": "PHI"}]}`,
+		"bad_json.json":  `{"season": `,
+		// A partial schedule where teams miss no week can't give byes
+		"partial_games.json": `{"season": "2030", "games": [{"week": 1, "home": "PHI", "away": "DAL"}]}`,
+		// Neither can one where a team misses two listed weeks
+		"two_byes.json": `{"season": "2030", "games": [{"week": 1, "home": "PHI", "away": "DAL"}, {"week": 2, "home": "NYG", "away": "WAS"}, {"week": 3, "home": "PHI", "away": "NYG"}]}`,
+	}
+	for name, data := range tests {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("Failed to write schedule: %v", err)
+		}
+		if err := store.LoadFile(path); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if err := store.LoadFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,232 @@
+package sleeper
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CacheConfig controls how long cached responses stay fresh
+type CacheConfig struct {
+	// Players data - updates daily
+	PlayersDataTTL time.Duration
+
+	// Live scores - matchups, stats, projections and NFL state during games
+	LiveScoresTTL time.Duration
+
+	// Completed weeks and seasons - final scores only change with stat corrections
+	CompletedDataTTL time.Duration
+
+	// Upper bound on cached responses; the entries closest to expiring are evicted first
+	MaxEntries int
+}
+
+// DefaultCacheConfig returns the default cache durations
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		PlayersDataTTL:   24 * time.Hour,
+		LiveScoresTTL:    30 * time.Second,
+		CompletedDataTTL: 6 * time.Hour,
+		MaxEntries:       2000,
+	}
+}
+
+// cacheSweepInterval is how often set drops expired entries
+const cacheSweepInterval = time.Minute
+
+// cacheEntry holds a cached value and its expiry
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// ttlCache is a small thread-safe in-memory cache with per-entry expiry. Expired
+// entries are swept on set, and the cache never holds more than maxEntries.
+type ttlCache struct {
+	mu         sync.Mutex
+	entries    map[string]cacheEntry
+	maxEntries int
+	nextSweep  time.Time
+	now        func() time.Time
+}
+
+func newTTLCache(maxEntries int) *ttlCache {
+	return &ttlCache{
+		entries:    make(map[string]cacheEntry),
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists || c.now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache) set(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !now.Before(c.nextSweep) {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextSweep = now.Add(cacheSweepInterval)
+	}
+
+	if _, exists := c.entries[key]; !exists && c.maxEntries > 0 {
+		for len(c.entries) >= c.maxEntries {
+			c.evictSoonestExpiring()
+		}
+	}
+
+	c.entries[key] = cacheEntry{value: value, expires: now.Add(ttl)}
+}
+
+// evictSoonestExpiring drops the entry closest to expiring. Callers hold the lock.
+func (c *ttlCache) evictSoonestExpiring() {
+	var soonestKey string
+	var soonest time.Time
+	for key, entry := range c.entries {
+		if soonestKey == "" || entry.expires.Before(soonest) {
+			soonestKey, soonest = key, entry.expires
+		}
+	}
+	delete(c.entries, soonestKey)
+}
+
+// CachedClient wraps a Client and serves players and scoring data from an in-memory
+// cache so repeated polling does not hit the Sleeper API each time. Weeks still being
+// played are cached briefly; completed weeks and seasons are cached much longer.
+// Cached values are shared between callers and must not be modified.
+type CachedClient struct {
+	Client
+	config CacheConfig
+	cache  *ttlCache
+}
+
+// NewCachedClient creates a caching wrapper around a Sleeper client
+func NewCachedClient(client Client, config CacheConfig) *CachedClient {
+	return &CachedClient{
+		Client: client,
+		config: config,
+		cache:  newTTLCache(config.MaxEntries),
+	}
+}
+
+// GetAllPlayers retrieves all NFL players, cached for PlayersDataTTL
+func (c *CachedClient) GetAllPlayers() (map[string]Player, error) {
+	key := "players:all"
+	if value, ok := c.cache.get(key); ok {
+		return value.(map[string]Player), nil
+	}
+
+	players, err := c.Client.GetAllPlayers()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, players, c.config.PlayersDataTTL)
+	return players, nil
+}
+
+// scoringTTL returns how long a week's scoring data stays fresh: CompletedDataTTL
+// once the week is over, LiveScoresTTL while it may still change
+func (c *CachedClient) scoringTTL(season string, week int) time.Duration {
+	state, err := c.GetNFLState()
+	if err != nil || season == "" {
+		return c.config.LiveScoresTTL
+	}
+	if season < state.Season || (season == state.Season && week < state.Week) {
+		return c.config.CompletedDataTTL
+	}
+	return c.config.LiveScoresTTL
+}
+
+// leagueSeason returns the season a league belongs to, which never changes, or ""
+// when the league can't be fetched
+func (c *CachedClient) leagueSeason(leagueID string) string {
+	key := fmt.Sprintf("league:%s:season", leagueID)
+	if value, ok := c.cache.get(key); ok {
+		return value.(string)
+	}
+
+	league, err := c.Client.GetLeague(leagueID)
+	if err != nil || league == nil {
+		return ""
+	}
+	c.cache.set(key, league.Season, c.config.CompletedDataTTL)
+	return league.Season
+}
+
+// GetMatchups retrieves matchups for a week, cached for LiveScoresTTL, or for
+// CompletedDataTTL once the week is over
+func (c *CachedClient) GetMatchups(leagueID string, week int) ([]Matchup, error) {
+	key := fmt.Sprintf("league:%s:matchups:%d", leagueID, week)
+	if value, ok := c.cache.get(key); ok {
+		return value.([]Matchup), nil
+	}
+
+	matchups, err := c.Client.GetMatchups(leagueID, week)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, matchups, c.scoringTTL(c.leagueSeason(leagueID), week))
+	return matchups, nil
+}
+
+// GetNFLState retrieves the NFL state, cached for LiveScoresTTL
+func (c *CachedClient) GetNFLState() (*NFLState, error) {
+	key := "state:nfl"
+	if value, ok := c.cache.get(key); ok {
+		return value.(*NFLState), nil
+	}
+
+	state, err := c.Client.GetNFLState()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, state, c.config.LiveScoresTTL)
+	return state, nil
+}
+
+// GetWeeklyStats retrieves a week's player stats, cached for LiveScoresTTL, or for
+// CompletedDataTTL once the week is over
+func (c *CachedClient) GetWeeklyStats(seasonType, season string, week int) (map[string]PlayerStats, error) {
+	key := fmt.Sprintf("stats:%s:%s:%d", seasonType, season, week)
+	if value, ok := c.cache.get(key); ok {
+		return value.(map[string]PlayerStats), nil
+	}
+
+	stats, err := c.Client.GetWeeklyStats(seasonType, season, week)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, stats, c.scoringTTL(season, week))
+	return stats, nil
+}
+
+// GetProjections retrieves a week's player projections, cached for LiveScoresTTL, or
+// for CompletedDataTTL once the week is over
+func (c *CachedClient) GetProjections(seasonType, season string, week int) (map[string]PlayerStats, error) {
+	key := fmt.Sprintf("projections:%s:%s:%d", seasonType, season, week)
+	if value, ok := c.cache.get(key); ok {
+		return value.(map[string]PlayerStats), nil
+	}
+
+	projections, err := c.Client.GetProjections(seasonType, season, week)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, projections, c.scoringTTL(season, week))
+	return projections, nil
+}
@@ -0,0 +1,152 @@
+package sleeper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+// newCacheTestServer serves a league in the 2024 season, an NFL state in week 5 of
+// 2024 and matchups for any week, counting matchups requests
+func newCacheTestServer(matchupRequests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch {
+		case strings.Contains(r.URL.Path, "/matchups/"):
+			*matchupRequests++
+			w.Write([]byte(`[{"roster_id": 1, "matchup_id": 1, "points": 101.5}]`))
+		case strings.HasPrefix(r.URL.Path, "/state/nfl"):
+			w.Write([]byte(`{"season": "2024", "week": 5, "season_type": "regular"}`))
+		default:
+			w.Write([]byte(`{"league_id": "123456789", "season": "2024"}`))
+		}
+	}))
+}
+
+func TestCachedClient_GetMatchups(t *testing.T) {
+	requests := 0
+	server := newCacheTestServer(&requests)
+	defer server.Close()
+
+	logger, _ := test.NewNullLogger()
+	client := NewCachedClient(&HTTPClient{
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}, DefaultCacheConfig())
+
+	now := time.Now()
+	client.cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		matchups, err := client.GetMatchups("123456789", 5)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(matchups) != 1 || matchups[0].Points != 101.5 {
+			t.Errorf("Expected cached matchup with 101.5 points, got %+v", matchups)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 API request while cache is fresh, got %d", requests)
+	}
+
+	// A different week is a different cache key
+	if _, err := client.GetMatchups("123456789", 4); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 API requests after new week, got %d", requests)
+	}
+
+	// Once the live TTL passes the current week goes back to the API...
+	now = now.Add(DefaultCacheConfig().LiveScoresTTL + time.Second)
+	if _, err := client.GetMatchups("123456789", 5); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 API requests after expiry, got %d", requests)
+	}
+
+	// ...but the completed week is still cached
+	if _, err := client.GetMatchups("123456789", 4); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected completed week to stay cached (3 requests), got %d", requests)
+	}
+
+	now = now.Add(DefaultCacheConfig().CompletedDataTTL)
+	if _, err := client.GetMatchups("123456789", 4); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 4 {
+		t.Errorf("Expected 4 API requests after completed week expiry, got %d", requests)
+	}
+}
+
+func TestTTLCache_Eviction(t *testing.T) {
+	now := time.Now()
+	cache := newTTLCache(2)
+	cache.now = func() time.Time { return now }
+
+	cache.set("short", 1, time.Second)
+	cache.set("long", 2, time.Hour)
+
+	// A full cache drops the entry closest to expiring
+	cache.set("medium", 3, time.Minute)
+	if _, ok := cache.get("short"); ok {
+		t.Error("Expected entry closest to expiring to be evicted")
+	}
+	if len(cache.entries) != 2 {
+		t.Errorf("Expected cache to hold 2 entries, got %d", len(cache.entries))
+	}
+
+	// Overwriting an existing key doesn't evict
+	cache.set("long", 4, time.Hour)
+	if _, ok := cache.get("medium"); !ok {
+		t.Error("Expected overwrite to keep other entries")
+	}
+
+	// Expired entries are swept on set without being read
+	now = now.Add(2 * time.Minute)
+	cache.set("fresh", 5, time.Hour)
+	if _, exists := cache.entries["medium"]; exists {
+		t.Error("Expected expired entry to be swept on set")
+	}
+	if value, ok := cache.get("long"); !ok || value != 4 {
+		t.Errorf("Expected overwritten entry 4, got %v", value)
+	}
+}
+
+func TestCachedClient_DoesNotCacheErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("Internal Server Error"))
+	}))
+	defer server.Close()
+
+	logger, _ := test.NewNullLogger()
+	client := NewCachedClient(&HTTPClient{
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}, DefaultCacheConfig())
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetNFLState(); err == nil {
+			t.Error("Expected error but got none")
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected errors not to be cached (2 requests), got %d", requests)
+	}
+}
@@ -33,6 +33,11 @@ type Client interface {
 	// Player methods
 	GetAllPlayers() (map[string]Player, error)
 	GetTrendingPlayers(sport, trendType string, hours, limit int) ([]TrendingPlayer, error)
+	
+	// NFL state and stats methods
+	GetNFLState() (*NFLState, error)
+	GetWeeklyStats(seasonType, season string, week int) (map[string]PlayerStats, error)
+	GetProjections(seasonType, season string, week int) (map[string]PlayerStats, error)
 }
 
 // HTTPClient implements the Client interface using HTTP requests
@@ -239,4 +244,40 @@ func (c *HTTPClient) GetLosersBracket(leagueID string) ([]BracketMatchup, error)
 	}
 	
 	return bracket, nil
+}
+
+// GetNFLState retrieves the current NFL season, week and season type
+func (c *HTTPClient) GetNFLState() (*NFLState, error) {
+	endpoint := "/state/nfl"
+	var state NFLState
+	
+	if err := c.makeRequest(endpoint, &state); err != nil {
+		return nil, fmt.Errorf("failed to get nfl state: %w", err)
+	}
+	
+	return &state, nil
+}
+
+// GetWeeklyStats retrieves every player's stats for a week, keyed by player ID
+func (c *HTTPClient) GetWeeklyStats(seasonType, season string, week int) (map[string]PlayerStats, error) {
+	endpoint := fmt.Sprintf("/stats/nfl/%s/%s/%d", seasonType, season, week)
+	var stats map[string]PlayerStats
+	
+	if err := c.makeRequest(endpoint, &stats); err != nil {
+		return nil, fmt.Errorf("failed to get stats for %s week %d: %w", season, week, err)
+	}
+	
+	return stats, nil
+}
+
+// GetProjections retrieves every player's projected stats for a week, keyed by player ID
+func (c *HTTPClient) GetProjections(seasonType, season string, week int) (map[string]PlayerStats, error) {
+	endpoint := fmt.Sprintf("/projections/nfl/%s/%s/%d", seasonType, season, week)
+	var projections map[string]PlayerStats
+	
+	if err := c.makeRequest(endpoint, &projections); err != nil {
+		return nil, fmt.Errorf("failed to get projections for %s week %d: %w", season, week, err)
+	}
+	
+	return projections, nil
 }
\ No newline at end of file
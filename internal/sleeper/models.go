@@ -1,20 +1,42 @@
 package sleeper
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // League represents a Sleeper fantasy league
 type League struct {
-	LeagueID        string                 `json:"league_id"`
-	Name            string                 `json:"name"`
-	Status          string                 `json:"status"`
-	Sport           string                 `json:"sport"`
-	Season          string                 `json:"season"`
-	Settings        LeagueSettings         `json:"settings"`
-	ScoringSettings map[string]float64     `json:"scoring_settings"`
-	RosterPositions []string               `json:"roster_positions"`
-	TotalRosters    int                    `json:"total_rosters"`
-	DraftID         string                 `json:"draft_id"`
-	Avatar          string                 `json:"avatar"`
+	LeagueID         string                 `json:"league_id"`
+	Name             string                 `json:"name"`
+	Status           string                 `json:"status"`
+	Sport            string                 `json:"sport"`
+	Season           string                 `json:"season"`
+	Settings         LeagueSettings         `json:"settings"`
+	RawSettings      map[string]interface{} `json:"-"` // Every setting as Sleeper sent it, including ones LeagueSettings doesn't model
+	ScoringSettings  map[string]float64     `json:"scoring_settings"`
+	RosterPositions  []string               `json:"roster_positions"`
+	TotalRosters     int                    `json:"total_rosters"`
+	DraftID          string                 `json:"draft_id"`
+	PreviousLeagueID string                 `json:"previous_league_id"` // Prior season's league, when renewed
+	Avatar           string                 `json:"avatar"`
+}
+
+// UnmarshalJSON decodes a league, keeping its raw settings alongside the typed ones
+func (l *League) UnmarshalJSON(data []byte) error {
+	type plainLeague League
+	if err := json.Unmarshal(data, (*plainLeague)(l)); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Settings map[string]interface{} `json:"settings"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	l.RawSettings = raw.Settings
+	return nil
 }
 
 // LeagueSettings contains league configuration
@@ -26,13 +48,22 @@ type LeagueSettings struct {
 	NumTeams             int `json:"num_teams"`
 	LeagueAverageMatch   int `json:"league_average_match"`
 	StartWeek            int `json:"start_week"`
+	PlayoffWeekStart     int `json:"playoff_week_start"`
 	LastScoredLeg        int `json:"last_scored_leg"`
 	Leg                  int `json:"leg"`
 	MaxKeepers           int `json:"max_keepers"`
 	DraftRounds          int `json:"draft_rounds"`
 	TradeDeadline        int `json:"trade_deadline"`
 	ReserveAllowCov      int `json:"reserve_allow_cov"`
+	ReserveAllowOut      int `json:"reserve_allow_out"`
+	ReserveAllowDoubtful int `json:"reserve_allow_doubtful"`
+	ReserveAllowSus      int `json:"reserve_allow_sus"`
+	ReserveAllowNA       int `json:"reserve_allow_na"`
+	ReserveAllowDNR      int `json:"reserve_allow_dnr"`
 	ReserveSlots         int `json:"reserve_slots"`
+	TaxiSlots            int `json:"taxi_slots"`
+	TaxiYears            int `json:"taxi_years"`      // Seasons of NFL experience allowed on the taxi squad; 0 is rookies only
+	TaxiAllowVets        int `json:"taxi_allow_vets"` // 1 lets any player onto the taxi squad
 	PlayoffType          int `json:"playoff_type"`
 	DailyWaivers         int `json:"daily_waivers"`
 	WaiverDayOfWeek      int `json:"waiver_day_of_week"`
@@ -40,16 +71,23 @@ type LeagueSettings struct {
 
 // User represents a Sleeper user
 type User struct {
-	UserID      string `json:"user_id"`
-	Username    string `json:"username"`
-	DisplayName string `json:"display_name"`
-	Avatar      string `json:"avatar"`
+	UserID      string       `json:"user_id"`
+	Username    string       `json:"username"`
+	DisplayName string       `json:"display_name"`
+	Avatar      string       `json:"avatar"`
+	Metadata    UserMetadata `json:"metadata"`
+}
+
+// UserMetadata contains league-specific user details
+type UserMetadata struct {
+	TeamName string `json:"team_name"`
 }
 
 // Roster represents a team's roster
 type Roster struct {
 	RosterID int      `json:"roster_id"`
 	OwnerID  string   `json:"owner_id"`
+	CoOwners []string `json:"co_owners"`
 	Players  []string `json:"players"`
 	Starters []string `json:"starters"`
 	Reserve  []string `json:"reserve"`
@@ -89,6 +127,7 @@ type Player struct {
 	Weight           string   `json:"weight"`
 	YearsExp         int      `json:"years_exp"`
 	College          string   `json:"college"`
+	SearchRank       int      `json:"search_rank"` // Sleeper's relevance rank; lower is more relevant, 0 when unranked
 }
 
 // TrendingPlayer represents a trending player for adds/drops
@@ -97,6 +136,21 @@ type TrendingPlayer struct {
 	Count    int    `json:"count"`
 }
 
+// NFLState represents the current state of the NFL season
+type NFLState struct {
+	Week            int    `json:"week"`
+	Leg             int    `json:"leg"`
+	Season          string `json:"season"`
+	SeasonType      string `json:"season_type"` // "pre", "regular", "post"
+	LeagueSeason    string `json:"league_season"`
+	DisplayWeek     int    `json:"display_week"`
+	SeasonStartDate string `json:"season_start_date"`
+}
+
+// PlayerStats maps stat keys (e.g. "pass_yd", "rec") to values for one player and week.
+// The keys match the keys used in League.ScoringSettings.
+type PlayerStats map[string]float64
+
 // Matchup represents a weekly matchup
 type Matchup struct {
 	RosterID       int                    `json:"roster_id"`
@@ -106,7 +160,7 @@ type Matchup struct {
 	StartersPoints []float64              `json:"starters_points"`
 	Players        []string               `json:"players"`
 	PlayersPoints  map[string]float64     `json:"players_points"`
-	CustomPoints   map[string]float64     `json:"custom_points"`
+	CustomPoints   *float64               `json:"custom_points"` // Commissioner override of Points, when set
 }
 
 // BracketMatchup represents a playoff bracket matchup from Sleeper's bracket API
@@ -167,7 +221,6 @@ type APIResponse struct {
 type Metadata struct {
 	Timestamp    time.Time `json:"timestamp"`
 	Source       string    `json:"source"`
-	CacheHit     bool      `json:"cache_hit"`
 	APICallsUsed int       `json:"api_calls_used"`
 	LeagueID     string    `json:"league_id,omitempty"`
 }